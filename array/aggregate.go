@@ -0,0 +1,151 @@
+package array
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Sum and Product wrap around on integer overflow like the + and * operators,
+// use SumChecked and ProductChecked to detect it.
+func Sum[T Number](arr []T) T {
+	var result T
+	for i := range arr {
+		result += arr[i]
+	}
+	return result
+}
+
+func Product[T Number](arr []T) T {
+	result := T(1)
+	for i := range arr {
+		result *= arr[i]
+	}
+	return result
+}
+
+// SumChecked reports false when the sum overflows T.
+func SumChecked[T constraints.Integer](arr []T) (T, bool) {
+	var result T
+	for i := range arr {
+		sum := result + arr[i]
+		if (arr[i] > 0 && sum < result) || (arr[i] < 0 && sum > result) {
+			return sum, false
+		}
+		result = sum
+	}
+	return result, true
+}
+
+// ProductChecked reports false when the product overflows T.
+func ProductChecked[T constraints.Integer](arr []T) (T, bool) {
+	result := T(1)
+	for i := range arr {
+		product := result * arr[i]
+		if result != 0 && arr[i] != 0 {
+			negative := (result < 0) != (arr[i] < 0)
+			if product/result != arr[i] || (product < 0) != negative {
+				return product, false
+			}
+		}
+		result = product
+	}
+	return result, true
+}
+
+// Average accumulates a running mean in float64, so it never overflows, even
+// when the sum of arr does not fit in T.
+func Average[T Number](arr []T) (float64, bool) {
+	if len(arr) == 0 {
+		return 0, false
+	}
+
+	mean := 0.0
+	for i := range arr {
+		mean += (float64(arr[i]) - mean) / float64(i+1)
+	}
+	return mean, true
+}
+
+func Median[T Number](arr []T) (float64, bool) {
+	N := len(arr)
+	if N == 0 {
+		return 0, false
+	}
+
+	sorted := CopyArray(arr)
+	slices.Sort(sorted)
+	if N%2 == 1 {
+		return float64(sorted[N/2]), true
+	}
+	a, b := float64(sorted[N/2-1]), float64(sorted[N/2])
+	return a + (b-a)/2, true
+}
+
+// Mode returns the most frequent element. Ties go to the element reaching that
+// frequency first.
+func Mode[T comparable](arr []T) (T, bool) {
+	var result T
+	if len(arr) == 0 {
+		return result, false
+	}
+
+	counts := map[T]int{}
+	best := 0
+	for i := range arr {
+		counts[arr[i]]++
+		if c := counts[arr[i]]; c > best {
+			best = c
+			result = arr[i]
+		}
+	}
+	return result, true
+}
+
+// Variance returns the population variance of arr, computed with Welford's
+// algorithm.
+func Variance[T Number](arr []T) (float64, bool) {
+	m2, ok := sumOfSquares(arr)
+	if !ok {
+		return 0, false
+	}
+	return m2 / float64(len(arr)), true
+}
+
+func SampleVariance[T Number](arr []T) (float64, bool) {
+	if len(arr) < 2 {
+		return 0, false
+	}
+	m2, _ := sumOfSquares(arr)
+	return m2 / float64(len(arr)-1), true
+}
+
+func StdDev[T Number](arr []T) (float64, bool) {
+	variance, ok := Variance(arr)
+	return math.Sqrt(variance), ok
+}
+
+func SampleStdDev[T Number](arr []T) (float64, bool) {
+	variance, ok := SampleVariance(arr)
+	return math.Sqrt(variance), ok
+}
+
+func sumOfSquares[T Number](arr []T) (float64, bool) {
+	if len(arr) == 0 {
+		return 0, false
+	}
+
+	mean, m2 := 0.0, 0.0
+	for i := range arr {
+		x := float64(arr[i])
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return m2, true
+}
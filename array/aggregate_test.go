@@ -0,0 +1,114 @@
+package array
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 10, Sum([]int{1, 2, 3, 4}))
+	assert.Equal(t, 0, Sum([]int(nil)))
+	assert.InDelta(t, 0.6, Sum([]float64{0.1, 0.2, 0.3}), 1e-9)
+
+	result, ok := SumChecked([]int8{100, 27})
+	assert.True(t, ok)
+	assert.Equal(t, int8(127), result)
+
+	_, ok = SumChecked([]int8{100, 28})
+	assert.False(t, ok)
+
+	_, ok = SumChecked([]int8{-100, -29})
+	assert.False(t, ok)
+
+	_, ok = SumChecked([]uint8{200, 56})
+	assert.False(t, ok)
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t, 24, Product([]int{1, 2, 3, 4}))
+	assert.Equal(t, 1, Product([]int(nil)))
+
+	result, ok := ProductChecked([]int8{-2, 64})
+	assert.True(t, ok)
+	assert.Equal(t, int8(-128), result)
+
+	_, ok = ProductChecked([]int8{2, 64})
+	assert.False(t, ok)
+
+	_, ok = ProductChecked([]int8{-128, -1})
+	assert.False(t, ok)
+
+	_, ok = ProductChecked([]uint8{16, 16})
+	assert.False(t, ok)
+
+	result, ok = ProductChecked([]int8{0, 127, 127})
+	assert.True(t, ok)
+	assert.Zero(t, result)
+}
+
+func TestAverage(t *testing.T) {
+	result, ok := Average([]int{1, 2, 3, 4})
+	assert.True(t, ok)
+	assert.InDelta(t, 2.5, result, 1e-9)
+
+	result, ok = Average([]int64{math.MaxInt64, math.MaxInt64})
+	assert.True(t, ok)
+	assert.InDelta(t, float64(math.MaxInt64), result, 1e3)
+
+	_, ok = Average([]int{})
+	assert.False(t, ok)
+}
+
+func TestMedian(t *testing.T) {
+	arr := []int{5, 1, 3}
+	result, ok := Median(arr)
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, result)
+	assert.Equal(t, []int{5, 1, 3}, arr)
+
+	result, ok = Median([]float64{4, 1, 3, 2})
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, result)
+
+	_, ok = Median([]int{})
+	assert.False(t, ok)
+}
+
+func TestMode(t *testing.T) {
+	result, ok := Mode([]int{1, 2, 2, 3, 3, 3})
+	assert.True(t, ok)
+	assert.Equal(t, 3, result)
+
+	word, ok := Mode([]string{"b", "a", "a", "b"})
+	assert.True(t, ok)
+	assert.Equal(t, "a", word)
+
+	_, ok = Mode([]int{})
+	assert.False(t, ok)
+}
+
+func TestVariance(t *testing.T) {
+	arr := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	result, ok := Variance(arr)
+	assert.True(t, ok)
+	assert.InDelta(t, 4.0, result, 1e-9)
+
+	result, ok = StdDev(arr)
+	assert.True(t, ok)
+	assert.InDelta(t, 2.0, result, 1e-9)
+
+	result, ok = SampleVariance(arr)
+	assert.True(t, ok)
+	assert.InDelta(t, 32.0/7, result, 1e-9)
+
+	result, ok = SampleStdDev(arr)
+	assert.True(t, ok)
+	assert.InDelta(t, math.Sqrt(32.0/7), result, 1e-9)
+
+	_, ok = Variance([]int{})
+	assert.False(t, ok)
+	_, ok = SampleVariance([]int{1})
+	assert.False(t, ok)
+}
@@ -0,0 +1,50 @@
+package array
+
+import (
+	"github.com/oculius/optio/collection"
+	"github.com/oculius/optio/fn"
+)
+
+// Distinct, DistinctBy, DistinctByEquivalence and DuplicatesOf keep the order in which elements first
+// appear and always return a new slice.
+func Distinct[T comparable](arr []T) []T {
+	return DistinctBy(arr, func(v T) T { return v })
+}
+
+func DistinctBy[T any, K comparable](arr []T, keyFn func(T) K) []T {
+	var result []T
+	seen := map[K]struct{}{}
+	for i := range arr {
+		key := keyFn(arr[i])
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			result = append(result, arr[i])
+		}
+	}
+	return result
+}
+
+func DistinctByEquivalence[T any](arr []T, eq fn.Equivalence[T]) []T {
+	var result []T
+	seen := collection.NewHashSet(eq)
+	for i := range arr {
+		if seen.Add(arr[i]) {
+			result = append(result, arr[i])
+		}
+	}
+	return result
+}
+
+// DuplicatesOf returns every element appearing more than once, each of them a
+// single time, ordered by their second appearance.
+func DuplicatesOf[T comparable](arr []T) []T {
+	var result []T
+	counts := map[T]int{}
+	for i := range arr {
+		counts[arr[i]]++
+		if counts[arr[i]] == 2 {
+			result = append(result, arr[i])
+		}
+	}
+	return result
+}
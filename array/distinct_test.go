@@ -0,0 +1,50 @@
+package array
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestDistinct(t *testing.T) {
+	arr := []int{3, 1, 3, 2, 1, 4}
+	result := Distinct(arr)
+
+	assert.Equal(t, []int{3, 1, 2, 4}, result)
+	assert.Equal(t, []int{3, 1, 3, 2, 1, 4}, arr)
+	assert.Nil(t, Distinct([]int(nil)))
+}
+
+func TestDistinctBy(t *testing.T) {
+	type user struct {
+		email string
+		roles []string
+	}
+	users := []user{
+		{"A@x.io", []string{"admin"}},
+		{"b@x.io", nil},
+		{"a@X.io", []string{"viewer"}},
+	}
+
+	result := DistinctBy(users, func(u user) string { return strings.ToLower(u.email) })
+
+	assert.Equal(t, []user{users[0], users[1]}, result)
+}
+
+func TestDistinctByEquivalence(t *testing.T) {
+	rows := [][]string{{"a", "b"}, {"A", "B"}, {"a"}, {"a", "b"}}
+	eq := fn.NewEquivalence(func(a, b []string) bool {
+		return strings.EqualFold(strings.Join(a, ","), strings.Join(b, ","))
+	}, func(v []string) uint64 {
+		return fn.NewCaseInsensitiveEquivalence().Hash(strings.Join(v, ","))
+	})
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"a"}}, DistinctByEquivalence(rows, eq))
+	assert.Nil(t, DistinctByEquivalence(nil, eq))
+}
+
+func TestDuplicatesOf(t *testing.T) {
+	assert.Equal(t, []string{"b", "a"}, DuplicatesOf([]string{"a", "b", "b", "c", "a", "a"}))
+	assert.Nil(t, DuplicatesOf([]string{"a", "b"}))
+}
@@ -0,0 +1,140 @@
+package array
+
+import "github.com/oculius/optio/fn"
+
+func FindLast[T any](arr []T, pred fn.SilentPredicate[T]) (idx int, found bool) {
+	idx = -1
+	for i := len(arr) - 1; i >= 0; i-- {
+		if pred(arr[i]) {
+			found = true
+			idx = i
+			return
+		}
+	}
+	return
+}
+
+func FindAll[T any](arr []T, pred fn.SilentPredicate[T]) []int {
+	var result []int
+	for i, val := range arr {
+		if pred(val) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func FindIndexed[T any](arr []T, pred fn.SilentBiPredicate[int, T]) (idx int, found bool) {
+	idx = -1
+	for i, val := range arr {
+		if pred(i, val) {
+			found = true
+			idx = i
+			return
+		}
+	}
+	return
+}
+
+func Count[T any](arr []T, pred fn.SilentPredicate[T]) int {
+	result := 0
+	for _, val := range arr {
+		if pred(val) {
+			result++
+		}
+	}
+	return result
+}
+
+// FindAndCutAll removes every element matching pred in a single pass. Like
+// FindAndCut, result is always a new slice.
+func FindAndCutAll[T any](arr []T, pred fn.SilentPredicate[T]) (idx []int, result []T, found bool) {
+	for i, val := range arr {
+		if pred(val) {
+			idx = append(idx, i)
+		}
+	}
+
+	found = len(idx) > 0
+	if !found {
+		result = CopyArray(arr)
+		return
+	}
+	if len(idx) == len(arr) {
+		return
+	}
+
+	result = make([]T, 0, len(arr)-len(idx))
+	prev := 0
+	for _, i := range idx {
+		result = append(result, arr[prev:i]...)
+		prev = i + 1
+	}
+	result = append(result, arr[prev:]...)
+	return
+}
+
+func IndexOf[T comparable](arr []T, value T) int {
+	for i := range arr {
+		if arr[i] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func LastIndexOf[T comparable](arr []T, value T) int {
+	for i := len(arr) - 1; i >= 0; i-- {
+		if arr[i] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func Contains[T comparable](arr []T, value T) bool {
+	return IndexOf(arr, value) >= 0
+}
+
+func ContainsBy[T any](arr []T, value T, eq fn.Equivalence[T]) bool {
+	for i := range arr {
+		if eq.Equals(arr[i], value) {
+			return true
+		}
+	}
+	return false
+}
+
+func ContainsAll[T comparable](arr []T, values ...T) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	table := make(map[T]bool, len(arr))
+	for i := range arr {
+		table[arr[i]] = true
+	}
+	for i := range values {
+		if !table[values[i]] {
+			return false
+		}
+	}
+	return true
+}
+
+func ContainsAny[T comparable](arr []T, values ...T) bool {
+	if len(values) == 0 || len(arr) == 0 {
+		return false
+	}
+
+	table := make(map[T]bool, len(values))
+	for i := range values {
+		table[values[i]] = true
+	}
+	for i := range arr {
+		if table[arr[i]] {
+			return true
+		}
+	}
+	return false
+}
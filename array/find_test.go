@@ -0,0 +1,97 @@
+package array
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFindLast(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+
+	idx, found := FindLast(arr, func(x int) bool { return x%2 == 0 })
+	assert.True(t, found)
+	assert.Equal(t, 3, idx)
+
+	idx, found = FindLast(arr, func(x int) bool { return x > 5 })
+	assert.False(t, found)
+	assert.Equal(t, -1, idx)
+}
+
+func TestFindAll(t *testing.T) {
+	arr := []string{"a", "bb", "c", "dd"}
+
+	assert.Equal(t, []int{1, 3}, FindAll(arr, func(x string) bool { return len(x) == 2 }))
+	assert.Nil(t, FindAll(arr, func(x string) bool { return len(x) == 3 }))
+}
+
+func TestFindIndexed(t *testing.T) {
+	arr := []int{5, 1, 2, 3}
+
+	idx, found := FindIndexed(arr, func(i int, x int) bool { return i == x })
+	assert.True(t, found)
+	assert.Equal(t, 1, idx)
+
+	idx, found = FindIndexed(arr, func(i int, x int) bool { return i > x*10 })
+	assert.False(t, found)
+	assert.Equal(t, -1, idx)
+}
+
+func TestCount(t *testing.T) {
+	assert.Equal(t, 2, Count([]int{1, 2, 3, 4, 5}, func(x int) bool { return x > 3 }))
+	assert.Equal(t, 0, Count([]int(nil), func(x int) bool { return true }))
+}
+
+func TestFindAndCutAll(t *testing.T) {
+	t.Run("normal case", func(tt *testing.T) {
+		arr := []int{1, 2, 3, 4, 5, 6}
+		idx, result, found := FindAndCutAll(arr, func(x int) bool { return x%2 == 0 })
+
+		assert.True(tt, found)
+		assert.Equal(tt, []int{1, 3, 5}, idx)
+		assert.Equal(tt, []int{1, 3, 5}, result)
+		assert.Equal(tt, []int{1, 2, 3, 4, 5, 6}, arr)
+	})
+
+	t.Run("not found", func(tt *testing.T) {
+		arr := []int{1, 3}
+		idx, result, found := FindAndCutAll(arr, func(x int) bool { return x%2 == 0 })
+		arr[0] = 7
+
+		assert.False(tt, found)
+		assert.Nil(tt, idx)
+		assert.Equal(tt, []int{1, 3}, result)
+	})
+
+	t.Run("all found", func(tt *testing.T) {
+		idx, result, found := FindAndCutAll([]int{2, 4}, func(x int) bool { return x%2 == 0 })
+
+		assert.True(tt, found)
+		assert.Equal(tt, []int{0, 1}, idx)
+		assert.Nil(tt, result)
+	})
+}
+
+func TestIndexOf(t *testing.T) {
+	arr := []string{"a", "b", "a"}
+
+	assert.Equal(t, 0, IndexOf(arr, "a"))
+	assert.Equal(t, 2, LastIndexOf(arr, "a"))
+	assert.Equal(t, -1, IndexOf(arr, "c"))
+	assert.Equal(t, -1, LastIndexOf(arr, "c"))
+}
+
+func TestContains(t *testing.T) {
+	arr := []int{1, 2, 3}
+
+	assert.True(t, Contains(arr, 2))
+	assert.False(t, Contains(arr, 4))
+	assert.True(t, ContainsAll(arr, 3, 1))
+	assert.True(t, ContainsAll(arr))
+	assert.False(t, ContainsAll(arr, 1, 4))
+	assert.True(t, ContainsAny(arr, 4, 3))
+	assert.False(t, ContainsAny(arr, 4, 5))
+	assert.False(t, ContainsAny(arr))
+	assert.True(t, ContainsBy([]string{"Go", "Rust"}, "rust", fn.NewCaseInsensitiveEquivalence()))
+	assert.False(t, ContainsBy([]string{"Go", "Rust"}, "zig", fn.NewCaseInsensitiveEquivalence()))
+}
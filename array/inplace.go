@@ -0,0 +1,144 @@
+package array
+
+import (
+	"math/rand"
+
+	"github.com/oculius/optio/fn"
+)
+
+// The functions in this file work in place on arr without allocating. Functions
+// returning a slice shorten arr and zero the elements past its new length, so
+// arr must not be used afterwards.
+
+func DeleteAt[T any](arr []T, idx int) []T {
+	return DeleteRange(arr, idx, idx+1)
+}
+
+// DeleteRange removes arr[from:to]. Out of range bounds leave arr unchanged.
+func DeleteRange[T any](arr []T, from, to int) []T {
+	N := len(arr)
+	if from < 0 || to > N || from >= to {
+		return arr
+	}
+
+	copy(arr[from:], arr[to:])
+	return clearTail(arr, N-(to-from))
+}
+
+// Compact replaces runs of equal adjacent elements with a single copy.
+func Compact[T comparable](arr []T) []T {
+	N := len(arr)
+	if N < 2 {
+		return arr
+	}
+
+	c := 1
+	for i := 1; i < N; i++ {
+		if arr[i] != arr[c-1] {
+			arr[c] = arr[i]
+			c++
+		}
+	}
+	return clearTail(arr, c)
+}
+
+// DedupeInPlace keeps the first occurrence of every element in their original
+// order. Unlike the other functions here it allocates a set of seen elements.
+func DedupeInPlace[T comparable](arr []T) []T {
+	N := len(arr)
+	if N < 2 {
+		return arr
+	}
+
+	seen := map[T]struct{}{}
+	c := 0
+	for i := 0; i < N; i++ {
+		if _, ok := seen[arr[i]]; !ok {
+			seen[arr[i]] = struct{}{}
+			arr[c] = arr[i]
+			c++
+		}
+	}
+	return clearTail(arr, c)
+}
+
+func Reverse[T any](arr []T) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// Rotate rotates arr left by k positions, or right when k is negative.
+func Rotate[T any](arr []T, k int) {
+	N := len(arr)
+	if N == 0 {
+		return
+	}
+
+	k %= N
+	if k < 0 {
+		k += N
+	}
+	if k == 0 {
+		return
+	}
+	Reverse(arr[:k])
+	Reverse(arr[k:])
+	Reverse(arr)
+}
+
+// Shuffle randomly permutes arr with a Fisher-Yates shuffle driven by src, or by
+// the global source of math/rand when src is nil.
+func Shuffle[T any](arr []T, src rand.Source) {
+	intn := rand.Intn
+	if src != nil {
+		intn = rand.New(src).Intn
+	}
+
+	for i := len(arr) - 1; i > 0; i-- {
+		j := intn(i + 1)
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// Partition moves the elements satisfying pred before the others and returns
+// their count. The relative order of the elements is not preserved.
+func Partition[T any](arr []T, pred fn.SilentPredicate[T]) int {
+	c := 0
+	for i := range arr {
+		if pred(arr[i]) {
+			arr[c], arr[i] = arr[i], arr[c]
+			c++
+		}
+	}
+	return c
+}
+
+// StablePartition is like Partition but keeps the relative order of the
+// elements. It runs in O(n log n) without allocating.
+func StablePartition[T any](arr []T, pred fn.SilentPredicate[T]) int {
+	N := len(arr)
+	switch N {
+	case 0:
+		return 0
+	case 1:
+		if pred(arr[0]) {
+			return 1
+		}
+		return 0
+	}
+
+	mid := N / 2
+	left := StablePartition(arr[:mid], pred)
+	right := StablePartition(arr[mid:], pred)
+	Rotate(arr[left:mid+right], mid-left)
+	return left + right
+}
+
+func clearTail[T any](arr []T, length int) []T {
+	var zero T
+	for i := length; i < len(arr); i++ {
+		arr[i] = zero
+	}
+	return arr[:length]
+}
@@ -0,0 +1,214 @@
+package array
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestDeleteAt(t *testing.T) {
+	t.Run("normal case", func(tt *testing.T) {
+		arr := []int{1, 2, 3, 4}
+		result := DeleteAt(arr, 1)
+
+		assert.Equal(tt, []int{1, 3, 4}, result)
+		assert.Equal(tt, []int{1, 3, 4, 0}, arr)
+	})
+
+	t.Run("out of range", func(tt *testing.T) {
+		assert.Equal(tt, []int{1, 2}, DeleteAt([]int{1, 2}, 2))
+		assert.Equal(tt, []int{1, 2}, DeleteAt([]int{1, 2}, -1))
+		assert.Equal(tt, []int(nil), DeleteAt([]int(nil), 0))
+	})
+}
+
+func TestDeleteRange(t *testing.T) {
+	assert.Equal(t, []int{1, 5}, DeleteRange([]int{1, 2, 3, 4, 5}, 1, 4))
+	assert.Equal(t, []int{}, DeleteRange([]int{1, 2}, 0, 2))
+	assert.Equal(t, []int{1, 2}, DeleteRange([]int{1, 2}, 1, 1))
+	assert.Equal(t, []int{1, 2}, DeleteRange([]int{1, 2}, 1, 3))
+}
+
+func TestCompact(t *testing.T) {
+	arr := []string{"a", "a", "b", "a", "c", "c", "c"}
+	result := Compact(arr)
+
+	assert.Equal(t, []string{"a", "b", "a", "c"}, result)
+	assert.Equal(t, []string{"a", "b", "a", "c", "", "", ""}, arr)
+	assert.Equal(t, []string{"a"}, Compact([]string{"a"}))
+}
+
+func TestDedupeInPlace(t *testing.T) {
+	result := DedupeInPlace([]int{3, 1, 3, 2, 1, 4})
+
+	assert.Equal(t, []int{3, 1, 2, 4}, result)
+	assert.Equal(t, []int(nil), DedupeInPlace([]int(nil)))
+}
+
+func TestReverse(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	Reverse(arr)
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, arr)
+
+	arr = []int{1, 2}
+	Reverse(arr)
+	assert.Equal(t, []int{2, 1}, arr)
+}
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		Title    string
+		K        int
+		Expected []int
+	}{
+		{"left", 2, []int{3, 4, 5, 1, 2}},
+		{"right", -1, []int{5, 1, 2, 3, 4}},
+		{"full", 5, []int{1, 2, 3, 4, 5}},
+		{"more than length", 7, []int{3, 4, 5, 1, 2}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Title, func(tt *testing.T) {
+			arr := []int{1, 2, 3, 4, 5}
+			Rotate(arr, tc.K)
+
+			assert.Equal(tt, tc.Expected, arr)
+		})
+	}
+
+	Rotate([]int{}, 3)
+}
+
+func TestShuffle(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	other := CopyArray(arr)
+	Shuffle(arr, rand.NewSource(42))
+	Shuffle(other, rand.NewSource(42))
+
+	assert.Equal(t, other, arr)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, arr)
+
+	Shuffle(arr, nil)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, arr)
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	t.Run("unstable", func(tt *testing.T) {
+		arr := []int{1, 2, 3, 4, 5, 6, 7}
+		idx := Partition(arr, isEven)
+
+		assert.Equal(tt, 3, idx)
+		assert.ElementsMatch(tt, []int{2, 4, 6}, arr[:idx])
+		assert.ElementsMatch(tt, []int{1, 3, 5, 7}, arr[idx:])
+	})
+
+	t.Run("stable", func(tt *testing.T) {
+		arr := []int{1, 2, 3, 4, 5, 6, 7}
+		idx := StablePartition(arr, isEven)
+
+		assert.Equal(tt, 3, idx)
+		assert.Equal(tt, []int{2, 4, 6, 1, 3, 5, 7}, arr)
+	})
+
+	t.Run("stable random", func(tt *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		for n := 1; n < 50; n++ {
+			arr := make([]int, n)
+			for i := range arr {
+				arr[i] = r.Intn(100)
+			}
+			expected := append(filterInts(arr, isEven), filterInts(arr, func(x int) bool { return !isEven(x) })...)
+			idx := StablePartition(arr, isEven)
+
+			assert.Equal(tt, expected, arr)
+			assert.Equal(tt, len(filterInts(arr, isEven)), idx)
+		}
+	})
+}
+
+func filterInts(arr []int, pred func(int) bool) []int {
+	var result []int
+	for _, x := range arr {
+		if pred(x) {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
+func benchmarkArray(n int) []int {
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = i % 97
+	}
+	return arr
+}
+
+func BenchmarkCutArray(b *testing.B) {
+	source := benchmarkArray(10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = CutArray(source, 5000)
+	}
+}
+
+func BenchmarkDeleteAt(b *testing.B) {
+	source := benchmarkArray(10000)
+	arr := make([]int, len(source))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(arr, source)
+		_ = DeleteAt(arr, 5000)
+	}
+}
+
+func BenchmarkFindAndCut(b *testing.B) {
+	source := benchmarkArray(10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = FindAndCut(source, func(x int) bool { return x == 96 })
+	}
+}
+
+func BenchmarkFindAndDeleteAt(b *testing.B) {
+	source := benchmarkArray(10000)
+	arr := make([]int, len(source))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(arr, source)
+		if idx, found := Find(arr, func(x int) bool { return x == 96 }); found {
+			_ = DeleteAt(arr, idx)
+		}
+	}
+}
+
+func BenchmarkDifferenceSetDedupe(b *testing.B) {
+	source := benchmarkArray(10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = DifferenceSet(source, []int{-1})
+	}
+}
+
+func BenchmarkDedupeInPlace(b *testing.B) {
+	source := benchmarkArray(10000)
+	arr := make([]int, len(source))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(arr, source)
+		_ = DedupeInPlace(arr)
+	}
+}
+
+func BenchmarkStablePartition(b *testing.B) {
+	source := benchmarkArray(10000)
+	arr := make([]int, len(source))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(arr, source)
+		_ = StablePartition(arr, func(x int) bool { return x%2 == 0 })
+	}
+}
@@ -0,0 +1,32 @@
+package array
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+// RemoveIf, RetainIf and ReplaceAll modify arr in place and return the
+// resulting slice, arr must not be used afterwards.
+func RemoveIf[T any](arr []T, pred fn.SilentPredicate[T]) []T {
+	iter := iterator.NewMutableIter(arr)
+	for iter.Next() {
+		if pred(iter.Value()) {
+			iter.Remove()
+		}
+	}
+	return iter.Result()
+}
+
+func RetainIf[T any](arr []T, pred fn.SilentPredicate[T]) []T {
+	return RemoveIf(arr, pred.Negate())
+}
+
+func ReplaceAll[T any](arr []T, pred fn.SilentPredicate[T], value T) []T {
+	iter := iterator.NewMutableIter(arr)
+	for iter.Next() {
+		if pred(iter.Value()) {
+			iter.Set(value)
+		}
+	}
+	return iter.Result()
+}
@@ -0,0 +1,33 @@
+package array
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRemoveIf(t *testing.T) {
+	t.Run("normal case", func(tt *testing.T) {
+		result := RemoveIf([]int{1, 2, 3, 4, 5}, func(x int) bool { return x%2 == 0 })
+
+		assert.Equal(tt, []int{1, 3, 5}, result)
+	})
+
+	t.Run("empty array", func(tt *testing.T) {
+		var arr []int
+		result := RemoveIf(arr, func(x int) bool { return true })
+
+		assert.Equal(tt, 0, len(result))
+	})
+}
+
+func TestRetainIf(t *testing.T) {
+	result := RetainIf([]string{"a", "bb", "ccc"}, func(x string) bool { return len(x) > 1 })
+
+	assert.Equal(t, []string{"bb", "ccc"}, result)
+}
+
+func TestReplaceAll(t *testing.T) {
+	result := ReplaceAll([]int{1, -2, 3, -4}, func(x int) bool { return x < 0 }, 0)
+
+	assert.Equal(t, []int{1, 0, 3, 0}, result)
+}
@@ -1,5 +1,10 @@
 package array
 
+import (
+	"github.com/oculius/optio/collection"
+	"github.com/oculius/optio/fn"
+)
+
 func IntersectSet[T comparable](firstArray []T, secondArray []T) []T {
 	var result []T
 	if len(firstArray) != 0 && len(secondArray) != 0 {
@@ -48,3 +53,45 @@ func DifferenceUnionSet[T comparable](firstArray []T, secondArray []T) []T {
 	secondDiff := DifferenceSet(secondArray, firstArray)
 	return Union(firstDiff, secondDiff)
 }
+
+// IntersectSetBy, DifferenceSetBy and DifferenceUnionSetBy behave like their
+// comparable counterparts, comparing elements with eq.
+func IntersectSetBy[T any](firstArray []T, secondArray []T, eq fn.Equivalence[T]) []T {
+	var result []T
+	if len(firstArray) != 0 && len(secondArray) != 0 {
+		table := collection.NewHashSet(eq, firstArray...)
+		for i := range secondArray {
+			if table.Remove(secondArray[i]) {
+				result = append(result, secondArray[i])
+			}
+		}
+	}
+
+	return result
+}
+
+func DifferenceSetBy[T any](firstArray []T, secondArray []T, eq fn.Equivalence[T]) []T {
+	var result []T
+	if len(firstArray) == 0 && len(secondArray) == 0 {
+		return result
+	} else if len(firstArray) == 0 {
+		return CopyArray(secondArray)
+	} else if len(secondArray) == 0 {
+		return CopyArray(firstArray)
+	}
+	table := collection.NewHashSet(eq, secondArray...)
+
+	for i := range firstArray {
+		if table.Add(firstArray[i]) {
+			result = append(result, firstArray[i])
+		}
+	}
+
+	return result
+}
+
+func DifferenceUnionSetBy[T any](firstArray []T, secondArray []T, eq fn.Equivalence[T]) []T {
+	firstDiff := DifferenceSetBy(firstArray, secondArray, eq)
+	secondDiff := DifferenceSetBy(secondArray, firstArray, eq)
+	return Union(firstDiff, secondDiff)
+}
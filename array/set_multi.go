@@ -0,0 +1,130 @@
+package array
+
+import "errors"
+
+var ErrTooManyArrays = errors.New("array: at most 64 arrays are supported")
+
+// IntersectAll, UnionDistinct and DifferenceAll return each element once, in the
+// order of its first appearance.
+func IntersectAll[T comparable](arrays ...[]T) []T {
+	if len(arrays) == 0 {
+		return nil
+	}
+
+	var result []T
+	counts := map[T]int{}
+	for i := range arrays[0] {
+		counts[arrays[0][i]] = 1
+	}
+	for n := 1; n < len(arrays); n++ {
+		for i := range arrays[n] {
+			if counts[arrays[n][i]] == n {
+				counts[arrays[n][i]] = n + 1
+			}
+		}
+	}
+	for i := range arrays[0] {
+		if counts[arrays[0][i]] == len(arrays) {
+			result = append(result, arrays[0][i])
+			counts[arrays[0][i]] = 0
+		}
+	}
+	return result
+}
+
+func UnionDistinct[T comparable](arrays ...[]T) []T {
+	var result []T
+	seen := map[T]struct{}{}
+	for n := range arrays {
+		for i := range arrays[n] {
+			if _, ok := seen[arrays[n][i]]; !ok {
+				seen[arrays[n][i]] = struct{}{}
+				result = append(result, arrays[n][i])
+			}
+		}
+	}
+	return result
+}
+
+func DifferenceAll[T comparable](first []T, rest ...[]T) []T {
+	var result []T
+	excluded := map[T]struct{}{}
+	for n := range rest {
+		for i := range rest[n] {
+			excluded[rest[n][i]] = struct{}{}
+		}
+	}
+	for i := range first {
+		if _, ok := excluded[first[i]]; !ok {
+			excluded[first[i]] = struct{}{}
+			result = append(result, first[i])
+		}
+	}
+	return result
+}
+
+// IsSubset reports whether every element of sub is in super.
+func IsSubset[T comparable](sub []T, super []T) bool {
+	return ContainsAll(super, sub...)
+}
+
+func IsDisjoint[T comparable](firstArray []T, secondArray []T) bool {
+	return !ContainsAny(firstArray, secondArray...)
+}
+
+// Jaccard returns the size of the intersection divided by the size of the union
+// of the distinct elements of both arrays, or 1 when both are empty.
+func Jaccard[T comparable](firstArray []T, secondArray []T) float64 {
+	first := map[T]struct{}{}
+	for i := range firstArray {
+		first[firstArray[i]] = struct{}{}
+	}
+
+	union := len(first)
+	intersection := 0
+	second := map[T]struct{}{}
+	for i := range secondArray {
+		if _, ok := second[secondArray[i]]; ok {
+			continue
+		}
+		second[secondArray[i]] = struct{}{}
+		if _, ok := first[secondArray[i]]; ok {
+			intersection++
+		} else {
+			union++
+		}
+	}
+
+	if union == 0 {
+		return 1
+	}
+	return float64(intersection) / float64(union)
+}
+
+// Venn groups the distinct elements of arrays by the set of arrays containing
+// them. Bit i of a key is set when arrays[i] contains the elements of that
+// region, and elements keep the order of their first appearance.
+func Venn[T comparable](arrays ...[]T) (map[uint64][]T, error) {
+	if len(arrays) > 64 {
+		return nil, ErrTooManyArrays
+	}
+
+	var order []T
+	masks := map[T]uint64{}
+	for n := range arrays {
+		for i := range arrays[n] {
+			mask, ok := masks[arrays[n][i]]
+			if !ok {
+				order = append(order, arrays[n][i])
+			}
+			masks[arrays[n][i]] = mask | 1<<n
+		}
+	}
+
+	result := map[uint64][]T{}
+	for i := range order {
+		mask := masks[order[i]]
+		result[mask] = append(result[mask], order[i])
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package array
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIntersectAll(t *testing.T) {
+	assert.Equal(t, []int{3, 2}, IntersectAll([]int{3, 1, 2, 3, 2}, []int{2, 3, 4}, []int{5, 2, 2, 3}))
+	assert.Equal(t, []int{1, 2}, IntersectAll([]int{1, 2, 1}))
+	assert.Nil(t, IntersectAll([]int{1, 2}, []int{3}))
+	assert.Nil(t, IntersectAll[int]())
+}
+
+func TestUnionDistinct(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c", "d"}, UnionDistinct([]string{"a", "b", "a"}, nil, []string{"c", "b", "d"}))
+	assert.Nil(t, UnionDistinct[string]())
+}
+
+func TestDifferenceAll(t *testing.T) {
+	assert.Equal(t, []int{1, 5}, DifferenceAll([]int{1, 2, 1, 3, 5, 4}, []int{2}, []int{3, 4}))
+	assert.Equal(t, []int{1, 2}, DifferenceAll([]int{1, 2, 2}))
+	assert.Nil(t, DifferenceAll(nil, []int{1}))
+}
+
+func TestSetRelations(t *testing.T) {
+	assert.True(t, IsSubset([]int{1, 2}, []int{3, 2, 1}))
+	assert.True(t, IsSubset(nil, []int{1}))
+	assert.False(t, IsSubset([]int{1, 4}, []int{3, 2, 1}))
+
+	assert.True(t, IsDisjoint([]int{1, 2}, []int{3, 4}))
+	assert.True(t, IsDisjoint(nil, []int{3, 4}))
+	assert.False(t, IsDisjoint([]int{1, 2}, []int{2, 4}))
+}
+
+func TestJaccard(t *testing.T) {
+	assert.InDelta(t, 0.5, Jaccard([]int{1, 2, 3, 3}, []int{2, 3, 4, 4}), 1e-9)
+	assert.InDelta(t, 1, Jaccard([]int{1, 2}, []int{2, 1}), 1e-9)
+	assert.InDelta(t, 0, Jaccard([]int{1}, nil), 1e-9)
+	assert.InDelta(t, 1, Jaccard[int](nil, nil), 1e-9)
+}
+
+func TestVenn(t *testing.T) {
+	t.Run("regions", func(tt *testing.T) {
+		regions, err := Venn([]string{"a", "b", "c", "a"}, []string{"b", "c", "d"}, []string{"c", "e"})
+
+		assert.NoError(tt, err)
+		assert.Equal(tt, map[uint64][]string{
+			0b001: {"a"},
+			0b011: {"b"},
+			0b111: {"c"},
+			0b010: {"d"},
+			0b100: {"e"},
+		}, regions)
+	})
+
+	t.Run("too many arrays", func(tt *testing.T) {
+		arrays := make([][]int, 65)
+		_, err := Venn(arrays...)
+		assert.ErrorIs(tt, err, ErrTooManyArrays)
+
+		regions, err := Venn(arrays[:64]...)
+		assert.NoError(tt, err)
+		assert.Empty(tt, regions)
+	})
+}
@@ -1,6 +1,7 @@
 package array
 
 import (
+	"github.com/oculius/optio/fn"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -137,3 +138,39 @@ func TestDifferenceUnionSet(t *testing.T) {
 
 	assert.Equal(t, []float64{1, 3.2, 5, 6, 2.8, 4, 7}, intersect)
 }
+
+func TestSetBy(t *testing.T) {
+	type tag struct {
+		name    string
+		aliases []string
+	}
+	eq := fn.NewKeyEquivalence(func(t tag) string { return t.name }, fn.NewCaseInsensitiveEquivalence())
+	first := []tag{{"Go", nil}, {"rust", []string{"rs"}}, {"GO", nil}, {"zig", nil}}
+	second := []tag{{"RUST", nil}, {"java", nil}, {"Rust", nil}, {"go", []string{"golang"}}}
+
+	t.Run("intersect", func(tt *testing.T) {
+		assert.Equal(tt, []tag{second[0], second[3]}, IntersectSetBy(first, second, eq))
+		assert.Nil(tt, IntersectSetBy(nil, second, eq))
+	})
+
+	t.Run("difference", func(tt *testing.T) {
+		assert.Equal(tt, []tag{first[3]}, DifferenceSetBy(first, second, eq))
+		assert.Equal(tt, []tag{second[1]}, DifferenceSetBy(second, first, eq))
+		assert.Equal(tt, second, DifferenceSetBy(nil, second, eq))
+		assert.Nil(tt, DifferenceSetBy[tag](nil, nil, eq))
+	})
+
+	t.Run("difference union", func(tt *testing.T) {
+		assert.Equal(tt, []tag{first[3], second[1]}, DifferenceUnionSetBy(first, second, eq))
+	})
+
+	t.Run("matches comparable variants", func(tt *testing.T) {
+		natural := fn.NewNaturalEquivalence[int]()
+		a := []int{1, 2, 2, 3, 5}
+		b := []int{5, 4, 2, 4}
+
+		assert.Equal(tt, IntersectSet(a, b), IntersectSetBy(a, b, natural))
+		assert.Equal(tt, DifferenceSet(a, b), DifferenceSetBy(a, b, natural))
+		assert.Equal(tt, DifferenceUnionSet(a, b), DifferenceUnionSetBy(a, b, natural))
+	})
+}
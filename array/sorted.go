@@ -0,0 +1,229 @@
+package array
+
+import (
+	"container/heap"
+
+	"github.com/oculius/optio/fn"
+	"golang.org/x/exp/constraints"
+)
+
+// The functions in this file expect arr to be sorted in ascending order of cmp,
+// or of < for their non By variants.
+
+func BinarySearch[T constraints.Ordered](arr []T, target T) (idx int, found bool) {
+	return BinarySearchBy(arr, target, fn.NewNaturalComparator[T]())
+}
+
+// BinarySearchBy returns the index of the first element equal to target, or the
+// index where target would be inserted when it is not found.
+func BinarySearchBy[T any](arr []T, target T, cmp fn.Comparator[T]) (idx int, found bool) {
+	idx = LowerBoundBy(arr, target, cmp)
+	found = idx < len(arr) && cmp(arr[idx], target) == 0
+	return
+}
+
+func LowerBound[T constraints.Ordered](arr []T, target T) int {
+	return LowerBoundBy(arr, target, fn.NewNaturalComparator[T]())
+}
+
+// LowerBoundBy returns the index of the first element not less than target.
+func LowerBoundBy[T any](arr []T, target T, cmp fn.Comparator[T]) int {
+	low, high := 0, len(arr)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmp(arr[mid], target) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+func UpperBound[T constraints.Ordered](arr []T, target T) int {
+	return UpperBoundBy(arr, target, fn.NewNaturalComparator[T]())
+}
+
+// UpperBoundBy returns the index of the first element greater than target.
+func UpperBoundBy[T any](arr []T, target T, cmp fn.Comparator[T]) int {
+	low, high := 0, len(arr)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmp(arr[mid], target) <= 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+func EqualRange[T constraints.Ordered](arr []T, target T) (from, to int) {
+	return EqualRangeBy(arr, target, fn.NewNaturalComparator[T]())
+}
+
+// EqualRangeBy returns the bounds of the elements equal to target, so that
+// arr[from:to] holds all of them.
+func EqualRangeBy[T any](arr []T, target T, cmp fn.Comparator[T]) (from, to int) {
+	return LowerBoundBy(arr, target, cmp), UpperBoundBy(arr, target, cmp)
+}
+
+func InsertSorted[T constraints.Ordered](arr []T, value T) []T {
+	return InsertSortedBy(arr, value, fn.NewNaturalComparator[T]())
+}
+
+// InsertSortedBy inserts value after the elements equal to it and returns the
+// resulting slice. Like append, it reuses the backing array of arr when it has
+// enough capacity.
+func InsertSortedBy[T any](arr []T, value T, cmp fn.Comparator[T]) []T {
+	idx := UpperBoundBy(arr, value, cmp)
+	var zero T
+	arr = append(arr, zero)
+	copy(arr[idx+1:], arr[idx:])
+	arr[idx] = value
+	return arr
+}
+
+func MergeSorted[T constraints.Ordered](arrays ...[]T) []T {
+	return MergeSortedBy(fn.NewNaturalComparator[T](), arrays...)
+}
+
+// MergeSortedBy merges sorted arrays into a new sorted slice with a k-way merge.
+// Equal elements keep the order of the arrays they come from.
+func MergeSortedBy[T any](cmp fn.Comparator[T], arrays ...[]T) []T {
+	N := 0
+	h := &mergeHeap[T]{cmp: cmp}
+	for i := range arrays {
+		N += len(arrays[i])
+		if len(arrays[i]) > 0 {
+			h.cursors = append(h.cursors, mergeCursor{array: i})
+		}
+	}
+	if N == 0 {
+		return nil
+	}
+
+	h.arrays = arrays
+	heap.Init(h)
+	result := make([]T, 0, N)
+	for h.Len() > 0 {
+		top := &h.cursors[0]
+		result = append(result, arrays[top.array][top.index])
+		top.index++
+		if top.index < len(arrays[top.array]) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return result
+}
+
+type mergeCursor struct {
+	array int
+	index int
+}
+
+type mergeHeap[T any] struct {
+	arrays  [][]T
+	cursors []mergeCursor
+	cmp     fn.Comparator[T]
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.cursors)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	result := h.cmp(h.arrays[a.array][a.index], h.arrays[b.array][b.index])
+	if result == 0 {
+		return a.array < b.array
+	}
+	return result < 0
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.cursors = append(h.cursors, x.(mergeCursor))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	N := len(h.cursors)
+	result := h.cursors[N-1]
+	h.cursors = h.cursors[:N-1]
+	return result
+}
+
+func IntersectSorted[T constraints.Ordered](firstArray []T, secondArray []T) []T {
+	return IntersectSortedBy(firstArray, secondArray, fn.NewNaturalComparator[T]())
+}
+
+// IntersectSortedBy, UnionSortedBy and DifferenceSortedBy run in linear time and
+// return sorted slices without duplicates, like IntersectSet and DifferenceSet.
+func IntersectSortedBy[T any](firstArray []T, secondArray []T, cmp fn.Comparator[T]) []T {
+	var result []T
+	i, j := 0, 0
+	for i < len(firstArray) && j < len(secondArray) {
+		c := cmp(firstArray[i], secondArray[j])
+		if c < 0 {
+			i++
+		} else if c > 0 {
+			j++
+		} else {
+			result = appendDistinct(result, firstArray[i], cmp)
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+func UnionSorted[T constraints.Ordered](firstArray []T, secondArray []T) []T {
+	return UnionSortedBy(firstArray, secondArray, fn.NewNaturalComparator[T]())
+}
+
+func UnionSortedBy[T any](firstArray []T, secondArray []T, cmp fn.Comparator[T]) []T {
+	var result []T
+	i, j := 0, 0
+	for i < len(firstArray) || j < len(secondArray) {
+		if j == len(secondArray) || (i < len(firstArray) && cmp(firstArray[i], secondArray[j]) <= 0) {
+			result = appendDistinct(result, firstArray[i], cmp)
+			i++
+		} else {
+			result = appendDistinct(result, secondArray[j], cmp)
+			j++
+		}
+	}
+	return result
+}
+
+func DifferenceSorted[T constraints.Ordered](firstArray []T, secondArray []T) []T {
+	return DifferenceSortedBy(firstArray, secondArray, fn.NewNaturalComparator[T]())
+}
+
+// DifferenceSortedBy returns the elements of firstArray missing from
+// secondArray.
+func DifferenceSortedBy[T any](firstArray []T, secondArray []T, cmp fn.Comparator[T]) []T {
+	var result []T
+	j := 0
+	for i := range firstArray {
+		for j < len(secondArray) && cmp(secondArray[j], firstArray[i]) < 0 {
+			j++
+		}
+		if j == len(secondArray) || cmp(secondArray[j], firstArray[i]) != 0 {
+			result = appendDistinct(result, firstArray[i], cmp)
+		}
+	}
+	return result
+}
+
+func appendDistinct[T any](arr []T, value T, cmp fn.Comparator[T]) []T {
+	if len(arr) > 0 && cmp(arr[len(arr)-1], value) == 0 {
+		return arr
+	}
+	return append(arr, value)
+}
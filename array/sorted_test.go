@@ -0,0 +1,112 @@
+package array
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type version struct {
+	major int
+	tags  []string
+}
+
+var byMajor = fn.Comparator[version](func(a, b version) int { return a.major - b.major })
+
+func TestBinarySearch(t *testing.T) {
+	arr := []int{1, 3, 3, 3, 7, 9}
+
+	t.Run("found", func(tt *testing.T) {
+		idx, found := BinarySearch(arr, 3)
+
+		assert.True(tt, found)
+		assert.Equal(tt, 1, idx)
+	})
+
+	t.Run("not found", func(tt *testing.T) {
+		idx, found := BinarySearch(arr, 8)
+
+		assert.False(tt, found)
+		assert.Equal(tt, 5, idx)
+
+		idx, found = BinarySearch([]int(nil), 8)
+		assert.False(tt, found)
+		assert.Equal(tt, 0, idx)
+	})
+
+	t.Run("by comparator", func(tt *testing.T) {
+		versions := []version{{1, nil}, {2, []string{"a"}}, {4, nil}}
+		idx, found := BinarySearchBy(versions, version{major: 2}, byMajor)
+
+		assert.True(tt, found)
+		assert.Equal(tt, 1, idx)
+	})
+}
+
+func TestBounds(t *testing.T) {
+	arr := []int{1, 3, 3, 3, 7, 9}
+
+	assert.Equal(t, 1, LowerBound(arr, 3))
+	assert.Equal(t, 4, UpperBound(arr, 3))
+	assert.Equal(t, 0, LowerBound(arr, 0))
+	assert.Equal(t, 6, UpperBound(arr, 9))
+
+	from, to := EqualRange(arr, 3)
+	assert.Equal(t, []int{3, 3, 3}, arr[from:to])
+
+	from, to = EqualRange(arr, 5)
+	assert.Equal(t, 4, from)
+	assert.Equal(t, 4, to)
+}
+
+func TestInsertSorted(t *testing.T) {
+	var arr []int
+	for _, x := range []int{5, 1, 3, 3, 9, 0} {
+		arr = InsertSorted(arr, x)
+	}
+	assert.Equal(t, []int{0, 1, 3, 3, 5, 9}, arr)
+
+	versions := InsertSortedBy([]version{{1, nil}, {2, []string{"a"}}}, version{2, []string{"b"}}, byMajor)
+	assert.Equal(t, []version{{1, nil}, {2, []string{"a"}}, {2, []string{"b"}}}, versions)
+}
+
+func TestMergeSorted(t *testing.T) {
+	t.Run("normal case", func(tt *testing.T) {
+		result := MergeSorted([]int{1, 4, 7}, nil, []int{2, 5, 8}, []int{0, 3, 6, 9, 10})
+
+		assert.Equal(tt, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, result)
+	})
+
+	t.Run("empty case", func(tt *testing.T) {
+		assert.Nil(tt, MergeSorted[int]())
+		assert.Nil(tt, MergeSorted([]int{}, nil))
+	})
+
+	t.Run("stable", func(tt *testing.T) {
+		result := MergeSortedBy(byMajor,
+			[]version{{1, []string{"a"}}, {2, []string{"a"}}},
+			[]version{{1, []string{"b"}}, {3, []string{"b"}}})
+
+		assert.Equal(tt, []version{{1, []string{"a"}}, {1, []string{"b"}}, {2, []string{"a"}}, {3, []string{"b"}}}, result)
+	})
+}
+
+func TestSortedSets(t *testing.T) {
+	first := []int{1, 2, 2, 4, 6, 8}
+	second := []int{2, 3, 4, 4, 5, 8, 9}
+
+	assert.Equal(t, []int{2, 4, 8}, IntersectSorted(first, second))
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 8, 9}, UnionSorted(first, second))
+	assert.Equal(t, []int{1, 6}, DifferenceSorted(first, second))
+	assert.Equal(t, []int{3, 5, 9}, DifferenceSorted(second, first))
+
+	assert.Nil(t, IntersectSorted(first, nil))
+	assert.Equal(t, []int{1, 2, 4, 6, 8}, UnionSorted(first, nil))
+	assert.Nil(t, DifferenceSorted(nil, second))
+
+	versions := []version{{1, []string{"a"}}, {3, nil}}
+	others := []version{{1, []string{"b"}}, {2, nil}}
+	assert.Equal(t, []version{{1, []string{"a"}}}, IntersectSortedBy(versions, others, byMajor))
+	assert.Equal(t, []version{{3, nil}}, DifferenceSortedBy(versions, others, byMajor))
+	assert.Equal(t, []version{{1, []string{"a"}}, {2, nil}, {3, nil}}, UnionSortedBy(versions, others, byMajor))
+}
@@ -0,0 +1,91 @@
+package array
+
+import (
+	"fmt"
+
+	"github.com/oculius/optio/fn"
+)
+
+// IndexError is returned by the Try functions, wrapping the first error returned
+// for the element at Index.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("array: index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+func TryForEach[T any](arr []T, consumer fn.Consumer[T]) error {
+	for i := range arr {
+		if err := consumer(arr[i]); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
+
+func TryFind[T any](arr []T, pred fn.Predicate[T]) (idx int, found bool, err error) {
+	idx = -1
+	for i, val := range arr {
+		ok, predErr := pred(val)
+		if predErr != nil {
+			err = &IndexError{Index: i, Err: predErr}
+			return
+		}
+		if ok {
+			found = true
+			idx = i
+			return
+		}
+	}
+	return
+}
+
+func TryFindAndCut[T any](arr []T, pred fn.Predicate[T]) (idx int, result []T, found bool, err error) {
+	idx, found, err = TryFind(arr, pred)
+	if err != nil {
+		return
+	}
+	if found {
+		result = CutArray(arr, idx)
+	} else {
+		result = CopyArray(arr)
+	}
+	return
+}
+
+func TryMap[T any, K any](arr []T, mapper func(T) (K, error)) ([]K, error) {
+	if arr == nil {
+		return nil, nil
+	}
+
+	result := make([]K, len(arr))
+	for i := range arr {
+		value, err := mapper(arr[i])
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		result[i] = value
+	}
+	return result, nil
+}
+
+func TryFilter[T any](arr []T, pred fn.Predicate[T]) ([]T, error) {
+	var result []T
+	for i := range arr {
+		ok, err := pred(arr[i])
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			result = append(result, arr[i])
+		}
+	}
+	return result, nil
+}
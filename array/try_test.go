@@ -0,0 +1,94 @@
+package array
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestTry(t *testing.T) {
+	someError := errors.New("some error occured")
+	failOn := func(bad int) func(int) (bool, error) {
+		return func(x int) (bool, error) {
+			if x == bad {
+				return false, someError
+			}
+			return x%2 == 0, nil
+		}
+	}
+	assertIndexError := func(tt *testing.T, err error, idx int) {
+		var indexErr *IndexError
+		assert.True(tt, errors.As(err, &indexErr))
+		assert.Equal(tt, idx, indexErr.Index)
+		assert.True(tt, errors.Is(err, someError))
+		assert.Equal(tt, "array: index "+strconv.Itoa(idx)+": some error occured", err.Error())
+	}
+
+	t.Run("for each", func(tt *testing.T) {
+		var visited []int
+		err := TryForEach([]int{1, 2, 3, 4}, func(x int) error {
+			if x == 3 {
+				return someError
+			}
+			visited = append(visited, x)
+			return nil
+		})
+
+		assertIndexError(tt, err, 2)
+		assert.Equal(tt, []int{1, 2}, visited)
+		assert.Nil(tt, TryForEach([]int{1}, func(int) error { return nil }))
+	})
+
+	t.Run("find", func(tt *testing.T) {
+		idx, found, err := TryFind([]int{1, 3, 4, 5}, failOn(5))
+		assert.Nil(tt, err)
+		assert.True(tt, found)
+		assert.Equal(tt, 2, idx)
+
+		idx, found, err = TryFind([]int{1, 3, 4, 5}, failOn(3))
+		assertIndexError(tt, err, 1)
+		assert.False(tt, found)
+		assert.Equal(tt, -1, idx)
+	})
+
+	t.Run("find and cut", func(tt *testing.T) {
+		idx, result, found, err := TryFindAndCut([]int{1, 3, 4, 5}, failOn(7))
+		assert.Nil(tt, err)
+		assert.True(tt, found)
+		assert.Equal(tt, 2, idx)
+		assert.Equal(tt, []int{1, 3, 5}, result)
+
+		_, result, found, err = TryFindAndCut([]int{1, 3}, failOn(7))
+		assert.Nil(tt, err)
+		assert.False(tt, found)
+		assert.Equal(tt, []int{1, 3}, result)
+
+		_, result, _, err = TryFindAndCut([]int{1, 3}, failOn(1))
+		assertIndexError(tt, err, 0)
+		assert.Nil(tt, result)
+	})
+
+	t.Run("map", func(tt *testing.T) {
+		result, err := TryMap([]string{"1", "2"}, strconv.Atoi)
+		assert.Nil(tt, err)
+		assert.Equal(tt, []int{1, 2}, result)
+
+		result, err = TryMap([]string{"1", "x"}, strconv.Atoi)
+		var indexErr *IndexError
+		assert.True(tt, errors.As(err, &indexErr))
+		assert.Equal(tt, 1, indexErr.Index)
+		assert.True(tt, errors.Is(err, strconv.ErrSyntax))
+		assert.Nil(tt, result)
+	})
+
+	t.Run("filter", func(tt *testing.T) {
+		result, err := TryFilter([]int{1, 2, 3, 4}, failOn(5))
+		assert.Nil(tt, err)
+		assert.Equal(tt, []int{2, 4}, result)
+
+		result, err = TryFilter([]int{1, 2, 3, 4}, failOn(4))
+		assertIndexError(tt, err, 3)
+		assert.Nil(tt, result)
+	})
+}
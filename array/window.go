@@ -0,0 +1,40 @@
+package array
+
+// Chunk and Windowed return sub-slices sharing the backing array of arr. Their
+// capacity is capped, so appending to a chunk or window never overwrites arr.
+func Chunk[T any](arr []T, size int) [][]T {
+	return Windowed(arr, size, size, true)
+}
+
+func Windowed[T any](arr []T, size, step int, partial bool) [][]T {
+	N := len(arr)
+	if N == 0 || size <= 0 || step <= 0 {
+		return nil
+	}
+
+	var result [][]T
+	for i := 0; i < N; i += step {
+		end := i + size
+		if end > N {
+			if !partial {
+				break
+			}
+			end = N
+		}
+		result = append(result, arr[i:end:end])
+	}
+	return result
+}
+
+func Pairwise[T any](arr []T) [][2]T {
+	N := len(arr)
+	if N < 2 {
+		return nil
+	}
+
+	result := make([][2]T, N-1)
+	for i := 1; i < N; i++ {
+		result[i-1] = [2]T{arr[i-1], arr[i]}
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package array
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	t.Run("normal case", func(tt *testing.T) {
+		arr := []int{1, 2, 3, 4, 5}
+		chunks := Chunk(arr, 2)
+
+		assert.Equal(tt, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+	})
+
+	t.Run("append does not overwrite source", func(tt *testing.T) {
+		arr := []int{1, 2, 3, 4}
+		chunks := Chunk(arr, 2)
+		_ = append(chunks[0], 9)
+
+		assert.Equal(tt, []int{1, 2, 3, 4}, arr)
+	})
+
+	t.Run("invalid case", func(tt *testing.T) {
+		assert.Nil(tt, Chunk([]int{}, 2))
+		assert.Nil(tt, Chunk([]int{1}, 0))
+	})
+}
+
+func TestWindowed(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+
+	t.Run("sliding", func(tt *testing.T) {
+		assert.Equal(tt, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, Windowed(arr, 3, 1, false))
+	})
+
+	t.Run("partial", func(tt *testing.T) {
+		assert.Equal(tt, [][]int{{1, 2, 3}, {3, 4, 5}, {5}}, Windowed(arr, 3, 2, true))
+	})
+
+	t.Run("step larger than size", func(tt *testing.T) {
+		assert.Equal(tt, [][]int{{1, 2}, {4, 5}}, Windowed(arr, 2, 3, false))
+	})
+
+	t.Run("size larger than array", func(tt *testing.T) {
+		assert.Nil(tt, Windowed(arr, 6, 1, false))
+		assert.Equal(tt, [][]int{{1, 2, 3, 4, 5}, {2, 3, 4, 5}, {3, 4, 5}, {4, 5}, {5}}, Windowed(arr, 6, 1, true))
+	})
+}
+
+func TestPairwise(t *testing.T) {
+	assert.Equal(t, [][2]string{{"a", "b"}, {"b", "c"}}, Pairwise([]string{"a", "b", "c"}))
+	assert.Nil(t, Pairwise([]string{"a"}))
+}
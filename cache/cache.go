@@ -0,0 +1,296 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/oculius/optio/fn"
+)
+
+var (
+	ErrNoLoader       = errors.New("cache: no loader configured")
+	ErrLoaderPanicked = errors.New("cache: loader panicked")
+)
+
+type Loader[K comparable, V any] func(K) (V, error)
+
+// Clock returns the current time. It is used for expiration so tests can
+// control time.
+type Clock func() time.Time
+
+type Config[K comparable, V any] struct {
+	// Capacity limits the number of entries, evicting according to Policy
+	// when exceeded. Zero means unbounded.
+	Capacity int
+	Policy   Policy
+
+	// TTL expires entries that long after they were stored. Zero means entries
+	// never expire.
+	TTL time.Duration
+
+	Loader  Loader[K, V]
+	OnEvict fn.SilentBiConsumer[K, V]
+	Clock   Clock
+}
+
+type Stats struct {
+	Hits          uint64
+	Misses        uint64
+	Evictions     uint64
+	LoadSuccesses uint64
+	LoadFailures  uint64
+}
+
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Cache is safe for concurrent use. Eviction listeners are called without the
+// cache lock held, after the operation that evicted the entries.
+type Cache[K comparable, V any] struct {
+	mu       sync.Mutex
+	config   Config[K, V]
+	entries  map[K]*entry[K, V]
+	policy   evictionPolicy[K, V]
+	inflight map[K]*call[V]
+	stats    Stats
+}
+
+// call is an in-flight load. A Put, Remove or Clear of its key while it runs
+// invalidates it, so its stale result is returned but not stored.
+type call[V any] struct {
+	done        sync.WaitGroup
+	value       V
+	err         error
+	invalidated bool
+}
+
+func NewCache[K comparable, V any](config Config[K, V]) *Cache[K, V] {
+	if config.Capacity < 0 {
+		config.Capacity = 0
+	}
+	if config.Clock == nil {
+		config.Clock = time.Now
+	}
+
+	return &Cache[K, V]{
+		config:   config,
+		entries:  map[K]*entry[K, V]{},
+		policy:   newEvictionPolicy[K, V](config.Policy),
+		inflight: map[K]*call[V]{},
+	}
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	value, ok, evicted := c.get(key)
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return value, ok
+}
+
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	c.invalidate(key)
+	evicted := c.put(key, value)
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Remove deletes key without notifying the eviction listener and reports
+// whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidate(key)
+	e, ok := c.entries[key]
+	if ok {
+		c.unlink(e)
+	}
+	return ok
+}
+
+// Load returns the value of key, loading it with the configured Loader on a
+// miss.
+func (c *Cache[K, V]) Load(key K) (V, error) {
+	if c.config.Loader == nil {
+		var zero V
+		return zero, ErrNoLoader
+	}
+	return c.GetOrLoad(key, func() (V, error) {
+		return c.config.Loader(key)
+	})
+}
+
+// GetOrLoad returns the value of key, loading it with loader on a miss.
+// Concurrent misses on the same key share a single load. Failed loads are not
+// cached, and callers waiting on a load that panicked get ErrLoaderPanicked.
+func (c *Cache[K, V]) GetOrLoad(key K, loader fn.Supplier[V]) (V, error) {
+	c.mu.Lock()
+	value, ok, evicted := c.get(key)
+	if ok {
+		c.mu.Unlock()
+		c.notify(evicted)
+		return value, nil
+	}
+	if pending, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		c.notify(evicted)
+		pending.done.Wait()
+		return pending.value, pending.err
+	}
+
+	current := &call[V]{}
+	current.done.Add(1)
+	c.inflight[key] = current
+	c.mu.Unlock()
+	c.notify(evicted)
+
+	defer func() {
+		c.mu.Lock()
+		if !current.invalidated {
+			delete(c.inflight, key)
+		}
+		if current.err == nil {
+			c.stats.LoadSuccesses++
+			evicted = nil
+			if !current.invalidated {
+				evicted = c.put(key, current.value)
+			}
+		} else {
+			c.stats.LoadFailures++
+			evicted = nil
+		}
+		c.mu.Unlock()
+
+		current.done.Done()
+		c.notify(evicted)
+	}()
+
+	current.err = ErrLoaderPanicked
+	current.value, current.err = loader()
+	return current.value, current.err
+}
+
+// Cleanup removes expired entries, notifying the eviction listener.
+func (c *Cache[K, V]) Cleanup() {
+	c.mu.Lock()
+	var evicted []*entry[K, V]
+	if c.config.TTL > 0 {
+		now := c.config.Clock().UnixNano()
+		for _, e := range c.entries {
+			if e.expiresAt <= now {
+				c.unlink(e)
+				c.stats.Evictions++
+				evicted = append(evicted, e)
+			}
+		}
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Clear removes every entry without notifying the eviction listener.
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, pending := range c.inflight {
+		pending.invalidated = true
+	}
+	c.inflight = map[K]*call[V]{}
+	c.entries = map[K]*entry[K, V]{}
+	c.policy = newEvictionPolicy[K, V](c.config.Policy)
+}
+
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+func (c *Cache[K, V]) get(key K) (V, bool, []*entry[K, V]) {
+	var zero V
+	e, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return zero, false, nil
+	}
+	if c.expired(e) {
+		c.unlink(e)
+		c.stats.Misses++
+		c.stats.Evictions++
+		return zero, false, []*entry[K, V]{e}
+	}
+
+	c.stats.Hits++
+	c.policy.access(e)
+	return e.value, true, nil
+}
+
+// invalidate detaches the in-flight load of key, so later misses start a new
+// load instead of waiting for a result that is already stale.
+func (c *Cache[K, V]) invalidate(key K) {
+	if pending, ok := c.inflight[key]; ok {
+		pending.invalidated = true
+		delete(c.inflight, key)
+	}
+}
+
+func (c *Cache[K, V]) put(key K, value V) []*entry[K, V] {
+	var expiresAt int64
+	if c.config.TTL > 0 {
+		expiresAt = c.config.Clock().Add(c.config.TTL).UnixNano()
+	}
+
+	if e, ok := c.entries[key]; ok {
+		e.value, e.expiresAt = value, expiresAt
+		c.policy.access(e)
+		return nil
+	}
+
+	var evicted []*entry[K, V]
+	for c.config.Capacity > 0 && len(c.entries) >= c.config.Capacity {
+		victim := c.policy.victim()
+		c.unlink(victim)
+		c.stats.Evictions++
+		evicted = append(evicted, victim)
+	}
+
+	e := &entry[K, V]{key: key, value: value, expiresAt: expiresAt}
+	c.entries[key] = e
+	c.policy.add(e)
+	return evicted
+}
+
+func (c *Cache[K, V]) expired(e *entry[K, V]) bool {
+	return c.config.TTL > 0 && e.expiresAt <= c.config.Clock().UnixNano()
+}
+
+func (c *Cache[K, V]) unlink(e *entry[K, V]) {
+	delete(c.entries, e.key)
+	c.policy.remove(e)
+}
+
+func (c *Cache[K, V]) notify(evicted []*entry[K, V]) {
+	if c.config.OnEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		c.config.OnEvict(e.key, e.value)
+	}
+}
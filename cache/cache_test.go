@@ -0,0 +1,269 @@
+package cache
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestCache(t *testing.T) {
+	t.Run("lru", func(tt *testing.T) {
+		var evicted []string
+		c := NewCache(Config[string, int]{
+			Capacity: 2,
+			OnEvict:  func(k string, _ int) { evicted = append(evicted, k) },
+		})
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Get("a")
+		c.Put("c", 3)
+
+		_, ok := c.Get("b")
+		assert.False(tt, ok)
+		value, ok := c.Get("a")
+		assert.True(tt, ok)
+		assert.Equal(tt, 1, value)
+		assert.Equal(tt, []string{"b"}, evicted)
+		assert.Equal(tt, 2, c.Len())
+
+		c.Put("a", 10)
+		c.Put("d", 4)
+		assert.Equal(tt, []string{"b", "c"}, evicted)
+		value, _ = c.Get("a")
+		assert.Equal(tt, 10, value)
+	})
+
+	t.Run("lfu", func(tt *testing.T) {
+		var evicted []string
+		c := NewCache(Config[string, int]{
+			Capacity: 3,
+			Policy:   PolicyLFU,
+			OnEvict:  func(k string, _ int) { evicted = append(evicted, k) },
+		})
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Put("c", 3)
+		c.Get("a")
+		c.Get("a")
+		c.Get("b")
+		c.Get("c")
+		c.Put("d", 4)
+		c.Put("e", 5)
+
+		assert.Equal(tt, []string{"b", "d"}, evicted)
+		for _, key := range []string{"a", "c", "e"} {
+			_, ok := c.Get(key)
+			assert.True(tt, ok, key)
+		}
+	})
+
+	t.Run("ttl", func(tt *testing.T) {
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		var evicted []string
+		c := NewCache(Config[string, int]{
+			TTL:     time.Minute,
+			Clock:   clock.Now,
+			OnEvict: func(k string, _ int) { evicted = append(evicted, k) },
+		})
+		c.Put("a", 1)
+		clock.Advance(30 * time.Second)
+		c.Put("b", 2)
+
+		_, ok := c.Get("a")
+		assert.True(tt, ok)
+
+		clock.Advance(30 * time.Second)
+		_, ok = c.Get("a")
+		assert.False(tt, ok)
+		assert.Equal(tt, []string{"a"}, evicted)
+
+		clock.Advance(30 * time.Second)
+		c.Cleanup()
+		assert.Equal(tt, []string{"a", "b"}, evicted)
+		assert.Equal(tt, 0, c.Len())
+	})
+
+	t.Run("remove and clear", func(tt *testing.T) {
+		evictions := 0
+		c := NewCache(Config[int, int]{OnEvict: func(int, int) { evictions++ }})
+		c.Put(1, 1)
+		c.Put(2, 2)
+
+		assert.True(tt, c.Remove(1))
+		assert.False(tt, c.Remove(1))
+		c.Clear()
+		assert.Equal(tt, 0, c.Len())
+		assert.Equal(tt, 0, evictions)
+	})
+
+	t.Run("stats", func(tt *testing.T) {
+		c := NewCache(Config[int, int]{Capacity: 1})
+		assert.Equal(tt, 0.0, c.Stats().HitRate())
+
+		c.Put(1, 1)
+		c.Get(1)
+		c.Get(2)
+		c.Put(2, 2)
+
+		assert.Equal(tt, Stats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+		assert.Equal(tt, 0.5, c.Stats().HitRate())
+	})
+}
+
+func TestCacheLoading(t *testing.T) {
+	t.Run("loader", func(tt *testing.T) {
+		loads := 0
+		c := NewCache(Config[int, int]{Loader: func(k int) (int, error) {
+			loads++
+			return k * 2, nil
+		}})
+
+		value, err := c.Load(2)
+		assert.NoError(tt, err)
+		assert.Equal(tt, 4, value)
+		value, err = c.Load(2)
+		assert.NoError(tt, err)
+		assert.Equal(tt, 4, value)
+		assert.Equal(tt, 1, loads)
+		assert.Equal(tt, uint64(1), c.Stats().LoadSuccesses)
+
+		_, err = NewCache(Config[int, int]{}).Load(1)
+		assert.ErrorIs(tt, err, ErrNoLoader)
+	})
+
+	t.Run("failed loads are not cached", func(tt *testing.T) {
+		c := NewCache(Config[string, int]{})
+		boom := errors.New("boom")
+
+		_, err := c.GetOrLoad("a", func() (int, error) { return 0, boom })
+		assert.ErrorIs(tt, err, boom)
+		assert.Equal(tt, 0, c.Len())
+		assert.Equal(tt, uint64(1), c.Stats().LoadFailures)
+
+		value, err := c.GetOrLoad("a", func() (int, error) { return 1, nil })
+		assert.NoError(tt, err)
+		assert.Equal(tt, 1, value)
+	})
+
+	t.Run("single flight", func(tt *testing.T) {
+		c := NewCache(Config[string, int]{})
+		var loads int32
+		release := make(chan struct{})
+		started := make(chan struct{})
+
+		var wg sync.WaitGroup
+		results := make([]int, 10)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = c.GetOrLoad("a", func() (int, error) {
+					atomic.AddInt32(&loads, 1)
+					close(started)
+					<-release
+					return 42, nil
+				})
+			}(i)
+		}
+
+		<-started
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(tt, int32(1), atomic.LoadInt32(&loads))
+		for _, result := range results {
+			assert.Equal(tt, 42, result)
+		}
+	})
+
+	t.Run("panicking loader", func(tt *testing.T) {
+		c := NewCache(Config[string, int]{})
+
+		assert.Panics(tt, func() {
+			_, _ = c.GetOrLoad("a", func() (int, error) { panic("boom") })
+		})
+		value, err := c.GetOrLoad("a", func() (int, error) { return 1, nil })
+		assert.NoError(tt, err)
+		assert.Equal(tt, 1, value)
+	})
+
+	t.Run("writes during a load", func(tt *testing.T) {
+		writes := map[string]func(c *Cache[string, int]){
+			"put":    func(c *Cache[string, int]) { c.Put("k", 2) },
+			"remove": func(c *Cache[string, int]) { c.Remove("k") },
+			"clear":  func(c *Cache[string, int]) { c.Clear() },
+		}
+		expected := map[string][]int{"put": {2}, "remove": nil, "clear": nil}
+
+		for name, write := range writes {
+			c := NewCache(Config[string, int]{})
+			started := make(chan struct{})
+			release := make(chan struct{})
+			done := make(chan int)
+			go func() {
+				value, _ := c.GetOrLoad("k", func() (int, error) {
+					close(started)
+					<-release
+					return 1, nil
+				})
+				done <- value
+			}()
+
+			<-started
+			write(c)
+			close(release)
+
+			assert.Equal(tt, 1, <-done, name)
+			value, ok := c.Get("k")
+			if want := expected[name]; want != nil {
+				assert.True(tt, ok, name)
+				assert.Equal(tt, want[0], value, name)
+			} else {
+				assert.False(tt, ok, name)
+			}
+			assert.Equal(tt, len(expected[name]), c.Len(), name)
+		}
+	})
+
+	t.Run("load after invalidation starts a new load", func(tt *testing.T) {
+		c := NewCache(Config[string, int]{})
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			_, _ = c.GetOrLoad("k", func() (int, error) {
+				close(started)
+				<-release
+				return 1, nil
+			})
+			close(done)
+		}()
+
+		<-started
+		c.Remove("k")
+		value, err := c.GetOrLoad("k", func() (int, error) { return 3, nil })
+		close(release)
+		<-done
+
+		assert.NoError(tt, err)
+		assert.Equal(tt, 3, value)
+		value, _ = c.Get("k")
+		assert.Equal(tt, 3, value)
+	})
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"container/heap"
+
+	"github.com/oculius/optio/collection"
+)
+
+type Policy uint8
+
+const (
+	// PolicyLRU evicts the least recently used entry.
+	PolicyLRU Policy = iota
+	// PolicyLFU evicts the least frequently used entry, the least recently used
+	// one among equally used entries.
+	PolicyLFU
+)
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt int64
+
+	node  *collection.Node[*entry[K, V]]
+	index int
+	freq  uint64
+	tick  uint64
+}
+
+type evictionPolicy[K comparable, V any] interface {
+	add(e *entry[K, V])
+	access(e *entry[K, V])
+	remove(e *entry[K, V])
+	victim() *entry[K, V]
+}
+
+func newEvictionPolicy[K comparable, V any](policy Policy) evictionPolicy[K, V] {
+	if policy == PolicyLFU {
+		return &lfuPolicy[K, V]{}
+	}
+	return &lruPolicy[K, V]{list: collection.NewList[*entry[K, V]]()}
+}
+
+type lruPolicy[K comparable, V any] struct {
+	list *collection.List[*entry[K, V]]
+}
+
+func (p *lruPolicy[K, V]) add(e *entry[K, V]) {
+	e.node = p.list.PushFront(e)
+}
+
+func (p *lruPolicy[K, V]) access(e *entry[K, V]) {
+	p.list.MoveToFront(e.node)
+}
+
+func (p *lruPolicy[K, V]) remove(e *entry[K, V]) {
+	p.list.Remove(e.node)
+}
+
+func (p *lruPolicy[K, V]) victim() *entry[K, V] {
+	if back := p.list.Back(); back != nil {
+		return back.Value
+	}
+	return nil
+}
+
+type lfuPolicy[K comparable, V any] struct {
+	entries []*entry[K, V]
+	tick    uint64
+}
+
+func (p *lfuPolicy[K, V]) add(e *entry[K, V]) {
+	p.tick++
+	e.freq, e.tick = 1, p.tick
+	heap.Push(p, e)
+}
+
+func (p *lfuPolicy[K, V]) access(e *entry[K, V]) {
+	p.tick++
+	e.freq++
+	e.tick = p.tick
+	heap.Fix(p, e.index)
+}
+
+func (p *lfuPolicy[K, V]) remove(e *entry[K, V]) {
+	heap.Remove(p, e.index)
+}
+
+func (p *lfuPolicy[K, V]) victim() *entry[K, V] {
+	if len(p.entries) == 0 {
+		return nil
+	}
+	return p.entries[0]
+}
+
+func (p *lfuPolicy[K, V]) Len() int {
+	return len(p.entries)
+}
+
+func (p *lfuPolicy[K, V]) Less(i, j int) bool {
+	a, b := p.entries[i], p.entries[j]
+	if a.freq != b.freq {
+		return a.freq < b.freq
+	}
+	return a.tick < b.tick
+}
+
+func (p *lfuPolicy[K, V]) Swap(i, j int) {
+	p.entries[i], p.entries[j] = p.entries[j], p.entries[i]
+	p.entries[i].index = i
+	p.entries[j].index = j
+}
+
+func (p *lfuPolicy[K, V]) Push(x any) {
+	e := x.(*entry[K, V])
+	e.index = len(p.entries)
+	p.entries = append(p.entries, e)
+}
+
+func (p *lfuPolicy[K, V]) Pop() any {
+	last := len(p.entries) - 1
+	e := p.entries[last]
+	p.entries[last] = nil
+	p.entries = p.entries[:last]
+	return e
+}
@@ -0,0 +1,167 @@
+package collection
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+const minDequeCapacity = 8
+
+// Deque is a double-ended queue backed by a ring buffer that doubles when full.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+func NewDeque[T any](values ...T) *Deque[T] {
+	d := NewDequeWithCapacity[T](len(values))
+	for i := range values {
+		d.PushBack(values[i])
+	}
+	return d
+}
+
+func NewDequeWithCapacity[T any](capacity int) *Deque[T] {
+	n := minDequeCapacity
+	for n < capacity {
+		n <<= 1
+	}
+	return &Deque[T]{buf: make([]T, n)}
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+	d.buf[d.index(d.size)] = value
+	d.size++
+}
+
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+	d.head = (d.head - 1) & (len(d.buf) - 1)
+	d.buf[d.head] = value
+	d.size++
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	d.size--
+	idx := d.index(d.size)
+	value := d.buf[idx]
+	d.buf[idx] = zero
+	return value, true
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	value := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) & (len(d.buf) - 1)
+	d.size--
+	return value, true
+}
+
+func (d *Deque[T]) Front() (T, bool) {
+	return d.Get(0)
+}
+
+func (d *Deque[T]) Back() (T, bool) {
+	return d.Get(d.size - 1)
+}
+
+// Get returns the element at idx counting from the front.
+func (d *Deque[T]) Get(idx int) (T, bool) {
+	if idx < 0 || idx >= d.size {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(idx)], true
+}
+
+func (d *Deque[T]) Clear() {
+	var zero T
+	for i := 0; i < d.size; i++ {
+		d.buf[d.index(i)] = zero
+	}
+	d.head, d.size = 0, 0
+}
+
+func (d *Deque[T]) ToSlice() []T {
+	if d.size == 0 {
+		return nil
+	}
+
+	result := make([]T, d.size)
+	n := copy(result, d.buf[d.head:])
+	copy(result[n:], d.buf[:d.size-n])
+	return result
+}
+
+func (d *Deque[T]) ForEach(consumer fn.SilentConsumer[T]) {
+	for i := 0; i < d.size; i++ {
+		consumer(d.buf[d.index(i)])
+	}
+}
+
+func (d *Deque[T]) Iterator() iterator.IIterator[T] {
+	return &indexIterator[T]{get: d.Get, len: d.Len, collect: d.ToSlice}
+}
+
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, len(d.buf)<<1)
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+	d.buf, d.head = buf, 0
+}
+
+// indexIterator walks containers with indexed access from front to back.
+type indexIterator[T any] struct {
+	get     func(int) (T, bool)
+	len     func() int
+	collect func() []T
+	index   int
+	value   T
+}
+
+func (it *indexIterator[T]) Next() bool {
+	if it.index >= it.len() {
+		return false
+	}
+
+	it.value, _ = it.get(it.index)
+	it.index++
+	return true
+}
+
+func (it *indexIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *indexIterator[T]) Reset() {
+	var zero T
+	it.index, it.value = 0, zero
+}
+
+func (it *indexIterator[T]) Collect() []T {
+	return it.collect()
+}
@@ -0,0 +1,123 @@
+package collection
+
+import (
+	"container/list"
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	t.Run("push and pop", func(tt *testing.T) {
+		d := NewDeque(2, 3)
+		d.PushFront(1)
+		d.PushBack(4)
+
+		front, _ := d.Front()
+		back, _ := d.Back()
+		assert.Equal(tt, 1, front)
+		assert.Equal(tt, 4, back)
+		assert.Equal(tt, []int{1, 2, 3, 4}, d.ToSlice())
+
+		value, ok := d.PopFront()
+		assert.True(tt, ok)
+		assert.Equal(tt, 1, value)
+		value, ok = d.PopBack()
+		assert.True(tt, ok)
+		assert.Equal(tt, 4, value)
+		assert.Equal(tt, 2, d.Len())
+
+		d.Clear()
+		_, ok = d.PopFront()
+		assert.False(tt, ok)
+		_, ok = d.PopBack()
+		assert.False(tt, ok)
+		_, ok = d.Back()
+		assert.False(tt, ok)
+		assert.Nil(tt, d.ToSlice())
+	})
+
+	t.Run("random operations", func(tt *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		d := NewDequeWithCapacity[int](0)
+		var model []int
+
+		for step := 0; step < 10000; step++ {
+			switch rng.Intn(4) {
+			case 0:
+				d.PushFront(step)
+				model = append([]int{step}, model...)
+			case 1:
+				d.PushBack(step)
+				model = append(model, step)
+			case 2:
+				value, ok := d.PopFront()
+				assert.Equal(tt, len(model) > 0, ok)
+				if len(model) > 0 {
+					assert.Equal(tt, model[0], value)
+					model = model[1:]
+				}
+			default:
+				value, ok := d.PopBack()
+				assert.Equal(tt, len(model) > 0, ok)
+				if len(model) > 0 {
+					assert.Equal(tt, model[len(model)-1], value)
+					model = model[:len(model)-1]
+				}
+			}
+		}
+
+		if len(model) == 0 {
+			model = nil
+		}
+		assert.Equal(tt, model, d.ToSlice())
+		for i := range model {
+			value, _ := d.Get(i)
+			assert.Equal(tt, model[i], value)
+		}
+	})
+
+	t.Run("iterate", func(tt *testing.T) {
+		d := NewDeque[int]()
+		for i := 0; i < 20; i++ {
+			d.PushFront(i)
+		}
+
+		var visited []int
+		d.ForEach(func(v int) { visited = append(visited, v) })
+		iter := d.Iterator()
+
+		assert.Equal(tt, visited, iterator.Collect[int](iter))
+		assert.Equal(tt, visited, iterator.Collect[int](iterator.FromIterator[int](iter)))
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 19, iter.Value())
+	})
+}
+
+func BenchmarkDeque(b *testing.B) {
+	b.ReportAllocs()
+	d := NewDeque[int]()
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+		d.PushFront(i)
+		if d.Len() > 1000 {
+			d.PopFront()
+			d.PopBack()
+		}
+	}
+}
+
+func BenchmarkDequeContainerList(b *testing.B) {
+	b.ReportAllocs()
+	l := list.New()
+	for i := 0; i < b.N; i++ {
+		l.PushBack(i)
+		l.PushFront(i)
+		if l.Len() > 1000 {
+			l.Remove(l.Front())
+			l.Remove(l.Back())
+		}
+	}
+}
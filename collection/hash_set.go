@@ -0,0 +1,177 @@
+package collection
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+// HashSet is a set whose elements are compared with an fn.Equivalence instead of
+// ==, so it can hold non-comparable values. Elements are kept in insertion
+// order until one is removed, which moves the last element into its place.
+type HashSet[T any] struct {
+	eq       fn.Equivalence[T]
+	elements []T
+	buckets  map[uint64][]int
+}
+
+func NewHashSet[T any](eq fn.Equivalence[T], values ...T) *HashSet[T] {
+	s := &HashSet[T]{
+		eq:      eq,
+		buckets: map[uint64][]int{},
+	}
+	for i := range values {
+		s.Add(values[i])
+	}
+	return s
+}
+
+func (s *HashSet[T]) Equivalence() fn.Equivalence[T] {
+	return s.eq
+}
+
+// Add inserts v unless an equal element is present and reports whether it did.
+func (s *HashSet[T]) Add(v T) bool {
+	h := s.eq.Hash(v)
+	if s.find(h, v) >= 0 {
+		return false
+	}
+
+	s.buckets[h] = append(s.buckets[h], len(s.elements))
+	s.elements = append(s.elements, v)
+	return true
+}
+
+// Remove deletes the element equal to v and reports whether there was one.
+func (s *HashSet[T]) Remove(v T) bool {
+	h := s.eq.Hash(v)
+	idx := s.find(h, v)
+	if idx < 0 {
+		return false
+	}
+
+	s.unlink(h, idx)
+	last := len(s.elements) - 1
+	if idx != last {
+		lastHash := s.eq.Hash(s.elements[last])
+		bucket := s.buckets[lastHash]
+		for i := range bucket {
+			if bucket[i] == last {
+				bucket[i] = idx
+				break
+			}
+		}
+		s.elements[idx] = s.elements[last]
+	}
+
+	var zero T
+	s.elements[last] = zero
+	s.elements = s.elements[:last]
+	return true
+}
+
+func (s *HashSet[T]) Contains(v T) bool {
+	return s.find(s.eq.Hash(v), v) >= 0
+}
+
+// Get returns the element of s equal to v.
+func (s *HashSet[T]) Get(v T) (T, bool) {
+	if idx := s.find(s.eq.Hash(v), v); idx >= 0 {
+		return s.elements[idx], true
+	}
+	var zero T
+	return zero, false
+}
+
+func (s *HashSet[T]) Len() int {
+	return len(s.elements)
+}
+
+func (s *HashSet[T]) Clear() {
+	s.elements = nil
+	s.buckets = map[uint64][]int{}
+}
+
+func (s *HashSet[T]) Values() []T {
+	if len(s.elements) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(s.elements))
+	copy(result, s.elements)
+	return result
+}
+
+func (s *HashSet[T]) ForEach(consumer fn.SilentConsumer[T]) {
+	for i := range s.elements {
+		consumer(s.elements[i])
+	}
+}
+
+// Iterator iterates over a snapshot of the elements.
+func (s *HashSet[T]) Iterator() iterator.IIterator[T] {
+	return iterator.NewIterator(s.Values())
+}
+
+func (s *HashSet[T]) Clone() *HashSet[T] {
+	result := NewHashSet(s.eq)
+	result.elements = s.Values()
+	for h, bucket := range s.buckets {
+		result.buckets[h] = append([]int(nil), bucket...)
+	}
+	return result
+}
+
+// Union, Intersect and Difference return a new set using the equivalence of s.
+func (s *HashSet[T]) Union(other *HashSet[T]) *HashSet[T] {
+	result := s.Clone()
+	for i := range other.elements {
+		result.Add(other.elements[i])
+	}
+	return result
+}
+
+func (s *HashSet[T]) Intersect(other *HashSet[T]) *HashSet[T] {
+	result := NewHashSet(s.eq)
+	for i := range s.elements {
+		if other.Contains(s.elements[i]) {
+			result.Add(s.elements[i])
+		}
+	}
+	return result
+}
+
+func (s *HashSet[T]) Difference(other *HashSet[T]) *HashSet[T] {
+	result := NewHashSet(s.eq)
+	for i := range s.elements {
+		if !other.Contains(s.elements[i]) {
+			result.Add(s.elements[i])
+		}
+	}
+	return result
+}
+
+func (s *HashSet[T]) find(h uint64, v T) int {
+	for _, idx := range s.buckets[h] {
+		if s.eq.Equals(s.elements[idx], v) {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (s *HashSet[T]) unlink(h uint64, idx int) {
+	bucket := s.buckets[h]
+	for i := range bucket {
+		if bucket[i] == idx {
+			bucket[i] = bucket[len(bucket)-1]
+			bucket = bucket[:len(bucket)-1]
+			break
+		}
+	}
+
+	if len(bucket) == 0 {
+		delete(s.buckets, h)
+	} else {
+		s.buckets[h] = bucket
+	}
+}
@@ -0,0 +1,83 @@
+package collection
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestHashSet(t *testing.T) {
+	t.Run("case insensitive", func(tt *testing.T) {
+		set := NewHashSet(fn.NewCaseInsensitiveEquivalence(), "Go", "go", "Rust")
+
+		assert.Equal(tt, 2, set.Len())
+		assert.Equal(tt, []string{"Go", "Rust"}, set.Values())
+		assert.True(tt, set.Contains("GO"))
+		assert.False(tt, set.Add("RUST"))
+
+		value, ok := set.Get("rust")
+		assert.True(tt, ok)
+		assert.Equal(tt, "Rust", value)
+	})
+
+	t.Run("remove", func(tt *testing.T) {
+		set := NewHashSet(fn.NewNaturalEquivalence[int](), 1, 2, 3, 4)
+
+		assert.True(tt, set.Remove(2))
+		assert.False(tt, set.Remove(2))
+		assert.Equal(tt, []int{1, 4, 3}, set.Values())
+		assert.True(tt, set.Contains(4))
+		assert.True(tt, set.Remove(4))
+		assert.True(tt, set.Remove(1))
+		assert.True(tt, set.Remove(3))
+		assert.Equal(tt, 0, set.Len())
+		assert.Nil(tt, set.Values())
+	})
+
+	t.Run("signed zero", func(tt *testing.T) {
+		set := NewHashSet(fn.NewNaturalEquivalence[float64](), 0.0, math.Copysign(0, -1))
+
+		assert.Equal(tt, 1, set.Len())
+	})
+
+	t.Run("colliding hashes", func(tt *testing.T) {
+		eq := fn.NewEquivalence(func(a, b []int) bool {
+			return len(a) == len(b) && (len(a) == 0 || a[0] == b[0])
+		}, func([]int) uint64 { return 0 })
+		set := NewHashSet(eq, []int{1}, []int{2}, []int{1}, nil)
+
+		assert.Equal(tt, [][]int{{1}, {2}, nil}, set.Values())
+		assert.True(tt, set.Remove([]int{1}))
+		assert.True(tt, set.Contains([]int{2}))
+		assert.True(tt, set.Contains([]int{}))
+		assert.False(tt, set.Contains([]int{1}))
+	})
+
+	t.Run("algebra", func(tt *testing.T) {
+		eq := fn.NewNaturalEquivalence[int]()
+		a := NewHashSet(eq, 1, 2, 3)
+		b := NewHashSet(eq, 2, 3, 4)
+
+		assert.Equal(tt, []int{1, 2, 3, 4}, a.Union(b).Values())
+		assert.Equal(tt, []int{2, 3}, a.Intersect(b).Values())
+		assert.Equal(tt, []int{1}, a.Difference(b).Values())
+		assert.Equal(tt, []int{1, 2, 3}, a.Values())
+	})
+
+	t.Run("clone, clear and iterate", func(tt *testing.T) {
+		set := NewHashSet(fn.NewNaturalEquivalence[string](), "a", "b")
+		clone := set.Clone()
+		set.Clear()
+		clone.Add("c")
+
+		var visited []string
+		clone.ForEach(func(v string) { visited = append(visited, v) })
+
+		assert.Equal(tt, 0, set.Len())
+		assert.False(tt, set.Contains("a"))
+		assert.Equal(tt, []string{"a", "b", "c"}, visited)
+		assert.Equal(tt, []string{"a", "b", "c"}, iterator.Collect[string](clone.Iterator()))
+	})
+}
@@ -0,0 +1,208 @@
+package collection
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+// Node is a handle to an element of a List. It stays valid until the element is
+// removed.
+type Node[T any] struct {
+	Value T
+	next  *Node[T]
+	prev  *Node[T]
+	list  *List[T]
+}
+
+func (n *Node[T]) Next() *Node[T] {
+	if next := n.next; n.list != nil && next != &n.list.root {
+		return next
+	}
+	return nil
+}
+
+func (n *Node[T]) Prev() *Node[T] {
+	if prev := n.prev; n.list != nil && prev != &n.list.root {
+		return prev
+	}
+	return nil
+}
+
+// List is a doubly linked list created with NewList. Methods taking a node do
+// nothing when the node belongs to another list.
+type List[T any] struct {
+	root Node[T]
+	size int
+}
+
+func NewList[T any](values ...T) *List[T] {
+	l := &List[T]{}
+	l.root.next, l.root.prev = &l.root, &l.root
+	for i := range values {
+		l.PushBack(values[i])
+	}
+	return l
+}
+
+func (l *List[T]) Len() int {
+	return l.size
+}
+
+func (l *List[T]) Front() *Node[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+func (l *List[T]) Back() *Node[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *List[T]) PushFront(value T) *Node[T] {
+	return l.insert(&Node[T]{Value: value}, &l.root)
+}
+
+func (l *List[T]) PushBack(value T) *Node[T] {
+	return l.insert(&Node[T]{Value: value}, l.root.prev)
+}
+
+func (l *List[T]) InsertBefore(value T, mark *Node[T]) *Node[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Node[T]{Value: value}, mark.prev)
+}
+
+func (l *List[T]) InsertAfter(value T, mark *Node[T]) *Node[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Node[T]{Value: value}, mark)
+}
+
+func (l *List[T]) Remove(node *Node[T]) T {
+	if node.list == l {
+		l.unlink(node)
+		node.next, node.prev, node.list = nil, nil, nil
+	}
+	return node.Value
+}
+
+func (l *List[T]) MoveToFront(node *Node[T]) {
+	if node.list != l || l.root.next == node {
+		return
+	}
+	l.move(node, &l.root)
+}
+
+func (l *List[T]) MoveToBack(node *Node[T]) {
+	if node.list != l || l.root.prev == node {
+		return
+	}
+	l.move(node, l.root.prev)
+}
+
+func (l *List[T]) MoveBefore(node, mark *Node[T]) {
+	if node.list != l || mark.list != l || node == mark {
+		return
+	}
+	l.move(node, mark.prev)
+}
+
+func (l *List[T]) MoveAfter(node, mark *Node[T]) {
+	if node.list != l || mark.list != l || node == mark {
+		return
+	}
+	l.move(node, mark)
+}
+
+func (l *List[T]) Clear() {
+	for node := l.root.next; node != &l.root; {
+		next := node.next
+		node.next, node.prev, node.list = nil, nil, nil
+		node = next
+	}
+	l.root.next, l.root.prev = &l.root, &l.root
+	l.size = 0
+}
+
+func (l *List[T]) ToSlice() []T {
+	if l.size == 0 {
+		return nil
+	}
+
+	result := make([]T, 0, l.size)
+	for node := l.root.next; node != &l.root; node = node.next {
+		result = append(result, node.Value)
+	}
+	return result
+}
+
+func (l *List[T]) ForEach(consumer fn.SilentConsumer[T]) {
+	for node := l.root.next; node != &l.root; node = node.next {
+		consumer(node.Value)
+	}
+}
+
+func (l *List[T]) Iterator() iterator.IIterator[T] {
+	return &listIterator[T]{list: l}
+}
+
+func (l *List[T]) insert(node, at *Node[T]) *Node[T] {
+	node.prev, node.next = at, at.next
+	at.next.prev = node
+	at.next = node
+	node.list = l
+	l.size++
+	return node
+}
+
+func (l *List[T]) unlink(node *Node[T]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	l.size--
+}
+
+func (l *List[T]) move(node, at *Node[T]) {
+	if node == at {
+		return
+	}
+	l.unlink(node)
+	l.insert(node, at)
+}
+
+type listIterator[T any] struct {
+	list    *List[T]
+	next    *Node[T]
+	started bool
+	value   T
+}
+
+func (it *listIterator[T]) Next() bool {
+	if !it.started {
+		it.next, it.started = it.list.root.next, true
+	}
+	if it.next == nil || it.next == &it.list.root {
+		return false
+	}
+
+	it.value = it.next.Value
+	it.next = it.next.next
+	return true
+}
+
+func (it *listIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *listIterator[T]) Reset() {
+	*it = listIterator[T]{list: it.list}
+}
+
+func (it *listIterator[T]) Collect() []T {
+	return it.list.ToSlice()
+}
@@ -0,0 +1,106 @@
+package collection
+
+import (
+	"container/list"
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	t.Run("insert and remove", func(tt *testing.T) {
+		l := NewList(2, 4)
+		one := l.PushFront(1)
+		three := l.InsertAfter(3, l.Front().Next())
+		l.InsertBefore(0, one)
+		five := l.PushBack(5)
+
+		assert.Equal(tt, []int{0, 1, 2, 3, 4, 5}, l.ToSlice())
+		assert.Equal(tt, 6, l.Len())
+		assert.Equal(tt, 3, l.Remove(three))
+		assert.Equal(tt, 3, l.Remove(three))
+		assert.Equal(tt, []int{0, 1, 2, 4, 5}, l.ToSlice())
+		assert.Nil(tt, five.Next())
+		assert.Nil(tt, l.Front().Prev())
+		assert.Equal(tt, 4, five.Prev().Value)
+		assert.Nil(tt, three.Next())
+		assert.Nil(tt, l.InsertAfter(6, three))
+	})
+
+	t.Run("move", func(tt *testing.T) {
+		l := NewList(1, 2, 3, 4)
+		first, last := l.Front(), l.Back()
+
+		l.MoveToBack(first)
+		assert.Equal(tt, []int{2, 3, 4, 1}, l.ToSlice())
+		l.MoveToFront(last)
+		assert.Equal(tt, []int{4, 2, 3, 1}, l.ToSlice())
+		l.MoveBefore(first, last)
+		assert.Equal(tt, []int{1, 4, 2, 3}, l.ToSlice())
+		l.MoveAfter(first, l.Back())
+		assert.Equal(tt, []int{4, 2, 3, 1}, l.ToSlice())
+		l.MoveAfter(first, first)
+		l.MoveToBack(first)
+		assert.Equal(tt, []int{4, 2, 3, 1}, l.ToSlice())
+
+		other := NewList(9)
+		l.MoveToFront(other.Front())
+		assert.Equal(tt, []int{4, 2, 3, 1}, l.ToSlice())
+		assert.Equal(tt, 9, l.Remove(other.Front()))
+		assert.Equal(tt, 1, other.Len())
+	})
+
+	t.Run("iterate and clear", func(tt *testing.T) {
+		l := NewList("a", "b", "c")
+		var visited []string
+		l.ForEach(func(v string) { visited = append(visited, v) })
+		iter := l.Iterator()
+
+		assert.Equal(tt, []string{"a", "b", "c"}, visited)
+		assert.Equal(tt, visited, iterator.Collect[string](iterator.FromIterator[string](iter)))
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, "a", iter.Value())
+
+		front := l.Front()
+		l.Clear()
+		assert.Equal(tt, 0, l.Len())
+		assert.Nil(tt, l.Front())
+		assert.Nil(tt, l.Back())
+		assert.Nil(tt, front.Next())
+		assert.Nil(tt, l.ToSlice())
+		assert.False(tt, l.Iterator().Next())
+	})
+}
+
+func BenchmarkList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := NewList[int]()
+		for j := 0; j < 1000; j++ {
+			l.PushBack(j)
+		}
+		sum := 0
+		l.ForEach(func(v int) { sum += v })
+		for l.Len() > 0 {
+			l.Remove(l.Front())
+		}
+	}
+}
+
+func BenchmarkContainerList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := list.New()
+		for j := 0; j < 1000; j++ {
+			l.PushBack(j)
+		}
+		sum := 0
+		for e := l.Front(); e != nil; e = e.Next() {
+			sum += e.Value.(int)
+		}
+		for l.Len() > 0 {
+			l.Remove(l.Front())
+		}
+	}
+}
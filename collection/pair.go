@@ -0,0 +1,10 @@
+package collection
+
+type Pair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+func NewPair[K, V any](key K, value V) Pair[K, V] {
+	return Pair[K, V]{Key: key, Value: value}
+}
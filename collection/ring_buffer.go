@@ -0,0 +1,123 @@
+package collection
+
+import (
+	"errors"
+
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+var ErrBufferFull = errors.New("collection: ring buffer is full")
+
+type OverflowPolicy uint8
+
+const (
+	// OverflowReject makes Push fail with ErrBufferFull when the buffer is full.
+	OverflowReject OverflowPolicy = iota
+	// OverflowOverwrite makes Push drop the oldest element when the buffer is
+	// full.
+	OverflowOverwrite
+)
+
+// RingBuffer is a fixed-capacity FIFO queue.
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int
+	size   int
+	policy OverflowPolicy
+}
+
+// NewRingBuffer creates a buffer holding at least one element.
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity), policy: policy}
+}
+
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.size == len(r.buf)
+}
+
+func (r *RingBuffer[T]) Push(value T) error {
+	if r.size == len(r.buf) {
+		if r.policy == OverflowReject {
+			return ErrBufferFull
+		}
+		r.buf[r.head] = value
+		r.head = (r.head + 1) % len(r.buf)
+		return nil
+	}
+
+	r.buf[r.index(r.size)] = value
+	r.size++
+	return nil
+}
+
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+
+	value := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return value, true
+}
+
+func (r *RingBuffer[T]) Peek() (T, bool) {
+	return r.Get(0)
+}
+
+// Get returns the element at idx counting from the oldest one.
+func (r *RingBuffer[T]) Get(idx int) (T, bool) {
+	if idx < 0 || idx >= r.size {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.index(idx)], true
+}
+
+func (r *RingBuffer[T]) Clear() {
+	var zero T
+	for i := 0; i < r.size; i++ {
+		r.buf[r.index(i)] = zero
+	}
+	r.head, r.size = 0, 0
+}
+
+func (r *RingBuffer[T]) ToSlice() []T {
+	if r.size == 0 {
+		return nil
+	}
+
+	result := make([]T, r.size)
+	for i := range result {
+		result[i] = r.buf[r.index(i)]
+	}
+	return result
+}
+
+func (r *RingBuffer[T]) ForEach(consumer fn.SilentConsumer[T]) {
+	for i := 0; i < r.size; i++ {
+		consumer(r.buf[r.index(i)])
+	}
+}
+
+func (r *RingBuffer[T]) Iterator() iterator.IIterator[T] {
+	return &indexIterator[T]{get: r.Get, len: r.Len, collect: r.ToSlice}
+}
+
+func (r *RingBuffer[T]) index(i int) int {
+	return (r.head + i) % len(r.buf)
+}
@@ -0,0 +1,77 @@
+package collection
+
+import (
+	"container/list"
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRingBuffer(t *testing.T) {
+	t.Run("reject", func(tt *testing.T) {
+		r := NewRingBuffer[int](3, OverflowReject)
+		for i := 1; i <= 3; i++ {
+			assert.NoError(tt, r.Push(i))
+		}
+
+		assert.True(tt, r.IsFull())
+		assert.ErrorIs(tt, r.Push(4), ErrBufferFull)
+		assert.Equal(tt, []int{1, 2, 3}, r.ToSlice())
+
+		value, ok := r.Pop()
+		assert.True(tt, ok)
+		assert.Equal(tt, 1, value)
+		assert.NoError(tt, r.Push(4))
+		assert.Equal(tt, []int{2, 3, 4}, r.ToSlice())
+	})
+
+	t.Run("overwrite", func(tt *testing.T) {
+		r := NewRingBuffer[int](3, OverflowOverwrite)
+		for i := 1; i <= 7; i++ {
+			assert.NoError(tt, r.Push(i))
+		}
+
+		oldest, _ := r.Peek()
+		assert.Equal(tt, 5, oldest)
+		assert.Equal(tt, 3, r.Len())
+		assert.Equal(tt, 3, r.Cap())
+		assert.Equal(tt, []int{5, 6, 7}, r.ToSlice())
+		assert.Equal(tt, []int{5, 6, 7}, iterator.Collect[int](iterator.FromIterator[int](r.Iterator())))
+
+		var visited []int
+		r.ForEach(func(v int) { visited = append(visited, v) })
+		assert.Equal(tt, []int{5, 6, 7}, visited)
+	})
+
+	t.Run("empty", func(tt *testing.T) {
+		r := NewRingBuffer[string](0, OverflowReject)
+		assert.Equal(tt, 1, r.Cap())
+		assert.NoError(tt, r.Push("a"))
+
+		r.Clear()
+		_, ok := r.Pop()
+		assert.False(tt, ok)
+		_, ok = r.Peek()
+		assert.False(tt, ok)
+		assert.Nil(tt, r.ToSlice())
+	})
+}
+
+func BenchmarkRingBuffer(b *testing.B) {
+	b.ReportAllocs()
+	r := NewRingBuffer[int](1000, OverflowOverwrite)
+	for i := 0; i < b.N; i++ {
+		_ = r.Push(i)
+	}
+}
+
+func BenchmarkRingBufferContainerList(b *testing.B) {
+	b.ReportAllocs()
+	l := list.New()
+	for i := 0; i < b.N; i++ {
+		l.PushBack(i)
+		if l.Len() > 1000 {
+			l.Remove(l.Front())
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package collection
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/oculius/optio/iterator"
+)
+
+// Trie maps string keys to values and supports prefix searches. Keys are split
+// into bytes, so prefixes may end inside a multi-byte rune; use RuneTrie to
+// only match whole runes.
+type Trie[V any] struct {
+	tree prefixTree[byte, V]
+}
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// Insert stores value under key and reports whether key was new.
+func (t *Trie[V]) Insert(key string, value V) bool {
+	return t.tree.insert([]byte(key), value)
+}
+
+func (t *Trie[V]) Get(key string) (V, bool) {
+	return t.tree.get([]byte(key))
+}
+
+func (t *Trie[V]) Delete(key string) bool {
+	return t.tree.delete([]byte(key))
+}
+
+func (t *Trie[V]) Len() int {
+	return t.tree.size
+}
+
+// LongestPrefixMatch returns the longest key that is a prefix of s.
+func (t *Trie[V]) LongestPrefixMatch(s string) (string, V, bool) {
+	n, value, ok := t.tree.longestPrefix([]byte(s))
+	return s[:n], value, ok
+}
+
+// PrefixIterator yields the entries whose key starts with prefix in
+// lexicographic order.
+func (t *Trie[V]) PrefixIterator(prefix string) iterator.IIterator[Pair[string, V]] {
+	return t.tree.iterator([]byte(prefix))
+}
+
+func (t *Trie[V]) Iterator() iterator.IIterator[Pair[string, V]] {
+	return t.PrefixIterator("")
+}
+
+func (t *Trie[V]) KeysWithPrefix(prefix string) []string {
+	return t.tree.keys([]byte(prefix))
+}
+
+// RuneTrie is a Trie splitting keys into runes. Bytes that are not valid UTF-8
+// are kept as units of their own, sorting after every rune, so distinct keys
+// never collide.
+type RuneTrie[V any] struct {
+	tree prefixTree[rune, V]
+}
+
+func NewRuneTrie[V any]() *RuneTrie[V] {
+	return &RuneTrie[V]{}
+}
+
+func (t *RuneTrie[V]) Insert(key string, value V) bool {
+	return t.tree.insert(toRunes(key), value)
+}
+
+func (t *RuneTrie[V]) Get(key string) (V, bool) {
+	return t.tree.get(toRunes(key))
+}
+
+func (t *RuneTrie[V]) Delete(key string) bool {
+	return t.tree.delete(toRunes(key))
+}
+
+func (t *RuneTrie[V]) Len() int {
+	return t.tree.size
+}
+
+func (t *RuneTrie[V]) LongestPrefixMatch(s string) (string, V, bool) {
+	runes := toRunes(s)
+	n, value, ok := t.tree.longestPrefix(runes)
+	size := 0
+	for _, r := range runes[:n] {
+		size += runeSize(r)
+	}
+	return s[:size], value, ok
+}
+
+func (t *RuneTrie[V]) PrefixIterator(prefix string) iterator.IIterator[Pair[string, V]] {
+	return t.tree.iterator(toRunes(prefix))
+}
+
+func (t *RuneTrie[V]) Iterator() iterator.IIterator[Pair[string, V]] {
+	return t.PrefixIterator("")
+}
+
+func (t *RuneTrie[V]) KeysWithPrefix(prefix string) []string {
+	return t.tree.keys(toRunes(prefix))
+}
+
+// invalidByte is the unit of the first byte that is not valid UTF-8; the
+// other bytes follow it, past utf8.MaxRune.
+const invalidByte = utf8.MaxRune + 1
+
+func toRunes(s string) []rune {
+	runes := make([]rune, 0, len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			r = invalidByte + rune(s[i])
+		}
+		runes = append(runes, r)
+		i += size
+	}
+	return runes
+}
+
+func runeSize(r rune) int {
+	if r >= invalidByte {
+		return 1
+	}
+	return utf8.RuneLen(r)
+}
+
+func runesToKey(runes []rune) string {
+	buf := make([]byte, 0, len(runes))
+	for _, r := range runes {
+		if r >= invalidByte {
+			buf = append(buf, byte(r-invalidByte))
+		} else {
+			buf = utf8.AppendRune(buf, r)
+		}
+	}
+	return string(buf)
+}
+
+type trieUnit interface {
+	byte | rune
+}
+
+// prefixTree is ready to use as its zero value.
+type prefixTree[K trieUnit, V any] struct {
+	root trieNode[K, V]
+	size int
+}
+
+func unitsToKey[K trieUnit](units []K) string {
+	switch u := any(units).(type) {
+	case []byte:
+		return string(u)
+	case []rune:
+		return runesToKey(u)
+	}
+	return ""
+}
+
+// trieNode keeps its children sorted by unit.
+type trieNode[K trieUnit, V any] struct {
+	units    []K
+	children []*trieNode[K, V]
+	value    V
+	hasValue bool
+}
+
+func (n *trieNode[K, V]) child(unit K) (int, bool) {
+	idx := sort.Search(len(n.units), func(i int) bool { return n.units[i] >= unit })
+	return idx, idx < len(n.units) && n.units[idx] == unit
+}
+
+func (t *prefixTree[K, V]) find(key []K) *trieNode[K, V] {
+	node := &t.root
+	for _, unit := range key {
+		idx, ok := node.child(unit)
+		if !ok {
+			return nil
+		}
+		node = node.children[idx]
+	}
+	return node
+}
+
+func (t *prefixTree[K, V]) insert(key []K, value V) bool {
+	node := &t.root
+	for _, unit := range key {
+		idx, ok := node.child(unit)
+		if !ok {
+			node.units = append(node.units, 0)
+			copy(node.units[idx+1:], node.units[idx:])
+			node.units[idx] = unit
+			node.children = append(node.children, nil)
+			copy(node.children[idx+1:], node.children[idx:])
+			node.children[idx] = &trieNode[K, V]{}
+		}
+		node = node.children[idx]
+	}
+
+	added := !node.hasValue
+	node.value, node.hasValue = value, true
+	if added {
+		t.size++
+	}
+	return added
+}
+
+func (t *prefixTree[K, V]) get(key []K) (V, bool) {
+	if node := t.find(key); node != nil && node.hasValue {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *prefixTree[K, V]) delete(key []K) bool {
+	path := make([]*trieNode[K, V], 0, len(key)+1)
+	node := &t.root
+	path = append(path, node)
+	for _, unit := range key {
+		idx, ok := node.child(unit)
+		if !ok {
+			return false
+		}
+		node = node.children[idx]
+		path = append(path, node)
+	}
+	if !node.hasValue {
+		return false
+	}
+
+	var zero V
+	node.value, node.hasValue = zero, false
+	t.size--
+
+	for i := len(key); i > 0; i-- {
+		if path[i].hasValue || len(path[i].units) > 0 {
+			break
+		}
+
+		parent := path[i-1]
+		idx, _ := parent.child(key[i-1])
+		parent.units = append(parent.units[:idx], parent.units[idx+1:]...)
+		copy(parent.children[idx:], parent.children[idx+1:])
+		parent.children[len(parent.children)-1] = nil
+		parent.children = parent.children[:len(parent.children)-1]
+	}
+	return true
+}
+
+// longestPrefix returns the length in units of the longest key prefixing s.
+func (t *prefixTree[K, V]) longestPrefix(s []K) (int, V, bool) {
+	var (
+		length int
+		value  V
+		found  bool
+	)
+
+	node := &t.root
+	for i := 0; ; i++ {
+		if node.hasValue {
+			length, value, found = i, node.value, true
+		}
+		if i == len(s) {
+			break
+		}
+
+		idx, ok := node.child(s[i])
+		if !ok {
+			break
+		}
+		node = node.children[idx]
+	}
+	return length, value, found
+}
+
+func (t *prefixTree[K, V]) iterator(prefix []K) iterator.IIterator[Pair[string, V]] {
+	it := &trieIterator[K, V]{tree: t, prefix: prefix}
+	it.Reset()
+	return it
+}
+
+func (t *prefixTree[K, V]) keys(prefix []K) []string {
+	var result []string
+	iter := t.iterator(prefix)
+	for iter.Next() {
+		result = append(result, iter.Value().Key)
+	}
+	return result
+}
+
+type trieFrame[K trieUnit, V any] struct {
+	node *trieNode[K, V]
+	next int
+}
+
+// trieIterator walks the tree depth first, yielding a node before its children
+// and children in unit order, which is the lexicographic order of their keys.
+type trieIterator[K trieUnit, V any] struct {
+	tree   *prefixTree[K, V]
+	prefix []K
+	path   []K
+	stack  []trieFrame[K, V]
+	value  Pair[string, V]
+}
+
+func (it *trieIterator[K, V]) Next() bool {
+	for len(it.stack) > 0 {
+		top := &it.stack[len(it.stack)-1]
+		if top.next < 0 {
+			top.next = 0
+			if top.node.hasValue {
+				it.value = NewPair(unitsToKey(it.path), top.node.value)
+				return true
+			}
+		}
+
+		if idx := top.next; idx < len(top.node.children) {
+			top.next++
+			it.path = append(it.path, top.node.units[idx])
+			it.stack = append(it.stack, trieFrame[K, V]{node: top.node.children[idx], next: -1})
+			continue
+		}
+
+		it.stack = it.stack[:len(it.stack)-1]
+		if len(it.stack) > 0 {
+			it.path = it.path[:len(it.path)-1]
+		}
+	}
+	return false
+}
+
+func (it *trieIterator[K, V]) Value() Pair[string, V] {
+	return it.value
+}
+
+func (it *trieIterator[K, V]) Reset() {
+	*it = trieIterator[K, V]{tree: it.tree, prefix: it.prefix}
+	if node := it.tree.find(it.prefix); node != nil {
+		it.path = append(it.path, it.prefix...)
+		it.stack = append(it.stack, trieFrame[K, V]{node: node, next: -1})
+	}
+}
+
+func (it *trieIterator[K, V]) Collect() []Pair[string, V] {
+	var result []Pair[string, V]
+	iter := it.tree.iterator(it.prefix)
+	for iter.Next() {
+		result = append(result, iter.Value())
+	}
+	return result
+}
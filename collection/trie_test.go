@@ -0,0 +1,178 @@
+package collection
+
+import (
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTrie(t *testing.T) {
+	t.Run("insert, get and delete", func(tt *testing.T) {
+		trie := NewTrie[int]()
+
+		assert.True(tt, trie.Insert("tea", 1))
+		assert.True(tt, trie.Insert("ten", 2))
+		assert.True(tt, trie.Insert("", 0))
+		assert.False(tt, trie.Insert("tea", 3))
+		assert.Equal(tt, 3, trie.Len())
+
+		value, ok := trie.Get("tea")
+		assert.True(tt, ok)
+		assert.Equal(tt, 3, value)
+		_, ok = trie.Get("te")
+		assert.False(tt, ok)
+		value, ok = trie.Get("")
+		assert.True(tt, ok)
+		assert.Equal(tt, 0, value)
+
+		assert.False(tt, trie.Delete("te"))
+		assert.False(tt, trie.Delete("teapot"))
+		assert.True(tt, trie.Delete("tea"))
+		assert.False(tt, trie.Delete("tea"))
+		assert.Equal(tt, 2, trie.Len())
+		_, ok = trie.Get("tea")
+		assert.False(tt, ok)
+		assert.Equal(tt, []string{"", "ten"}, trie.KeysWithPrefix(""))
+	})
+
+	t.Run("longest prefix match", func(tt *testing.T) {
+		trie := NewTrie[string]()
+		trie.Insert("/api", "api")
+		trie.Insert("/api/users", "users")
+
+		key, value, ok := trie.LongestPrefixMatch("/api/users/42")
+		assert.True(tt, ok)
+		assert.Equal(tt, "/api/users", key)
+		assert.Equal(tt, "users", value)
+
+		key, value, ok = trie.LongestPrefixMatch("/api/orders")
+		assert.True(tt, ok)
+		assert.Equal(tt, "/api", key)
+		assert.Equal(tt, "api", value)
+
+		key, _, ok = trie.LongestPrefixMatch("/health")
+		assert.False(tt, ok)
+		assert.Equal(tt, "", key)
+	})
+
+	t.Run("prefix iterator", func(tt *testing.T) {
+		trie := NewTrie[int]()
+		for i, word := range []string{"tent", "tea", "to", "ten", "team", "inn", "t"} {
+			trie.Insert(word, i)
+		}
+
+		iter := trie.PrefixIterator("te")
+		assert.Equal(tt, []Pair[string, int]{
+			NewPair("tea", 1), NewPair("team", 4), NewPair("ten", 3), NewPair("tent", 0),
+		}, iterator.Collect[Pair[string, int]](iterator.FromIterator[Pair[string, int]](iter)))
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, NewPair("tea", 1), iter.Value())
+
+		assert.Equal(tt, []string{"inn", "t", "tea", "team", "ten", "tent", "to"}, trie.KeysWithPrefix(""))
+		assert.Equal(tt, 7, len(trie.Iterator().Collect()))
+		assert.Nil(tt, trie.KeysWithPrefix("x"))
+
+		mapped := iterator.NewMapIter(trie.PrefixIterator("tea"), func(p Pair[string, int]) string { return p.Key })
+		assert.Equal(tt, []string{"tea", "team"}, mapped.Collect())
+	})
+
+	t.Run("matches sorted keys", func(tt *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		trie := NewTrie[int]()
+		model := map[string]int{}
+		for i := 0; i < 2000; i++ {
+			var sb strings.Builder
+			for j := rng.Intn(6); j > 0; j-- {
+				sb.WriteByte("abc"[rng.Intn(3)])
+			}
+			key := sb.String()
+			if rng.Intn(3) == 0 {
+				_, exists := model[key]
+				assert.Equal(tt, exists, trie.Delete(key))
+				delete(model, key)
+			} else {
+				trie.Insert(key, i)
+				model[key] = i
+			}
+		}
+
+		var expected []string
+		for key := range model {
+			if strings.HasPrefix(key, "ab") {
+				expected = append(expected, key)
+			}
+		}
+		sort.Strings(expected)
+
+		assert.Equal(tt, len(model), trie.Len())
+		assert.Equal(tt, expected, trie.KeysWithPrefix("ab"))
+		for _, pair := range trie.Iterator().Collect() {
+			assert.Equal(tt, model[pair.Key], pair.Value)
+		}
+	})
+}
+
+func TestRuneTrie(t *testing.T) {
+	trie := NewRuneTrie[int]()
+	trie.Insert("日本", 1)
+	trie.Insert("日本語", 2)
+	trie.Insert("日曜日", 3)
+	trie.Insert("zebra", 4)
+
+	value, ok := trie.Get("日本語")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 4, trie.Len())
+	assert.Equal(t, []string{"日曜日", "日本", "日本語"}, trie.KeysWithPrefix("日"))
+	assert.Equal(t, []Pair[string, int]{NewPair("日本", 1), NewPair("日本語", 2)}, trie.PrefixIterator("日本").Collect())
+	assert.Equal(t, "zebra", trie.Iterator().Collect()[0].Key)
+
+	key, value, ok := trie.LongestPrefixMatch("日本語です")
+	assert.True(t, ok)
+	assert.Equal(t, "日本語", key)
+	assert.Equal(t, 2, value)
+
+	assert.True(t, trie.Delete("日本"))
+	assert.False(t, trie.Delete("日"))
+	assert.Equal(t, []string{"日曜日", "日本語"}, trie.KeysWithPrefix("日"))
+
+	bytes := NewTrie[int]()
+	bytes.Insert("日", 1)
+	_, _, ok = bytes.LongestPrefixMatch("日本")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"日"}, bytes.KeysWithPrefix("\xe6"))
+	assert.Nil(t, trie.KeysWithPrefix("\xe6"))
+}
+
+func TestTrieZeroValue(t *testing.T) {
+	var trie Trie[int]
+	var runes RuneTrie[int]
+
+	assert.True(t, trie.Insert("go", 1))
+	assert.True(t, runes.Insert("日本", 2))
+	assert.Equal(t, []string{"go"}, trie.KeysWithPrefix("g"))
+	assert.Equal(t, []Pair[string, int]{NewPair("日本", 2)}, runes.PrefixIterator("日").Collect())
+}
+
+func TestRuneTrieInvalidUTF8(t *testing.T) {
+	trie := NewRuneTrie[int]()
+
+	assert.True(t, trie.Insert("a\xff", 1))
+	assert.True(t, trie.Insert("a\xfe", 2))
+	assert.True(t, trie.Insert("a\uFFFD", 3))
+	assert.Equal(t, 3, trie.Len())
+
+	value, ok := trie.Get("a\xff")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	key, value, ok := trie.LongestPrefixMatch("a\xffz")
+	assert.True(t, ok)
+	assert.Equal(t, "a\xff", key)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, []string{"a\uFFFD", "a\xfe", "a\xff"}, trie.KeysWithPrefix("a"))
+}
@@ -0,0 +1,158 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/oculius/optio/fn"
+)
+
+var (
+	ErrBusClosed          = errors.New("event: bus is closed")
+	ErrQueueFull          = errors.New("event: subscriber queue is full")
+	ErrSubscriptionClosed = errors.New("event: subscription is closed")
+	ErrNilConsumer        = errors.New("event: consumer is nil")
+	ErrInvalidQueueSize   = errors.New("event: async queue size must be positive")
+)
+
+type Bus[T any] struct {
+	mu      sync.RWMutex
+	closed  bool
+	topics  map[string][]*Subscription[T]
+	workers sync.WaitGroup
+}
+
+func NewBus[T any]() *Bus[T] {
+	return &Bus[T]{topics: map[string][]*Subscription[T]{}}
+}
+
+func (b *Bus[T]) Subscribe(topic string, consumer fn.Consumer[T]) (*Subscription[T], error) {
+	return b.SubscribeWith(topic, consumer, SubscribeConfig[T]{})
+}
+
+func (b *Bus[T]) SubscribeSilent(topic string, consumer fn.SilentConsumer[T]) (*Subscription[T], error) {
+	if consumer == nil {
+		return nil, ErrNilConsumer
+	}
+	return b.Subscribe(topic, consumer.ToConsumer())
+}
+
+func (b *Bus[T]) SubscribeWith(topic string, consumer fn.Consumer[T], config SubscribeConfig[T]) (*Subscription[T], error) {
+	if consumer == nil {
+		return nil, ErrNilConsumer
+	}
+	if config.Async && config.QueueSize <= 0 {
+		return nil, ErrInvalidQueueSize
+	}
+
+	sub := &Subscription[T]{
+		bus:      b,
+		topic:    topic,
+		consumer: consumer,
+		config:   config,
+	}
+	if config.Async {
+		sub.queue = make(chan T, config.QueueSize)
+		sub.done = make(chan struct{})
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrBusClosed
+	}
+
+	b.topics[topic] = append(b.topics[topic], sub)
+	if sub.queue != nil {
+		b.workers.Add(1)
+		go sub.run(&b.workers)
+	}
+	return sub, nil
+}
+
+func (b *Bus[T]) Publish(topic string, event T) error {
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return ErrBusClosed
+	}
+	subs := b.topics[topic]
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		sub.deliver(event)
+	}
+	return nil
+}
+
+func (b *Bus[T]) Topics() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	result := make([]string, 0, len(b.topics))
+	for topic := range b.topics {
+		result = append(result, topic)
+	}
+	return result
+}
+
+func (b *Bus[T]) SubscriberCount(topic string) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.topics[topic])
+}
+
+// Shutdown stops the bus from accepting new events and subscribers, then waits
+// until every async subscriber has drained its queue or ctx is done.
+func (b *Bus[T]) Shutdown(ctx context.Context) error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return ErrBusClosed
+	}
+	b.closed = true
+	topics := b.topics
+	b.topics = map[string][]*Subscription[T]{}
+	b.mu.Unlock()
+
+	for _, subs := range topics {
+		for _, sub := range subs {
+			sub.close()
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		b.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *Bus[T]) remove(sub *Subscription[T]) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subs := b.topics[sub.topic]
+	for i := range subs {
+		if subs[i] == sub {
+			result := make([]*Subscription[T], 0, len(subs)-1)
+			result = append(result, subs[:i]...)
+			result = append(result, subs[i+1:]...)
+			if len(result) == 0 {
+				delete(b.topics, sub.topic)
+			} else {
+				b.topics[sub.topic] = result
+			}
+			return true
+		}
+	}
+	return false
+}
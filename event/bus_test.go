@@ -0,0 +1,235 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus(t *testing.T) {
+	t.Run("sync subscribers", func(tt *testing.T) {
+		bus := NewBus[int]()
+		var received []int
+		sub, err := bus.SubscribeSilent("numbers", func(x int) {
+			received = append(received, x)
+		})
+		assert.Nil(tt, err)
+		assert.Equal(tt, "numbers", sub.Topic())
+		assert.Equal(tt, 1, bus.SubscriberCount("numbers"))
+
+		assert.Nil(tt, bus.Publish("numbers", 1))
+		assert.Nil(tt, bus.Publish("others", 2))
+		assert.Nil(tt, bus.Publish("numbers", 3))
+		assert.Equal(tt, []int{1, 3}, received)
+
+		sub.Unsubscribe()
+		sub.Unsubscribe()
+		assert.Nil(tt, bus.Publish("numbers", 4))
+		assert.Equal(tt, []int{1, 3}, received)
+		assert.Equal(tt, 0, bus.SubscriberCount("numbers"))
+	})
+
+	t.Run("filter and error handler", func(tt *testing.T) {
+		bus := NewBus[int]()
+		someError := errors.New("odd number")
+		var errs []error
+		var received []int
+		_, err := bus.SubscribeWith("numbers", func(x int) error {
+			received = append(received, x)
+			if x%2 == 1 {
+				return someError
+			}
+			return nil
+		}, SubscribeConfig[int]{
+			Filter:       func(x int) bool { return x > 0 },
+			ErrorHandler: func(err error) { errs = append(errs, err) },
+		})
+		assert.Nil(tt, err)
+
+		for _, x := range []int{-1, 1, 2, 3} {
+			assert.Nil(tt, bus.Publish("numbers", x))
+		}
+		assert.Equal(tt, []int{1, 2, 3}, received)
+		assert.Equal(tt, []error{someError, someError}, errs)
+	})
+
+	t.Run("async subscribers drain on shutdown", func(tt *testing.T) {
+		bus := NewBus[int]()
+		var mu sync.Mutex
+		sum := 0
+		_, err := bus.SubscribeWith("numbers", func(x int) error {
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			sum += x
+			mu.Unlock()
+			return nil
+		}, SubscribeConfig[int]{Async: true, QueueSize: 4})
+		assert.Nil(tt, err)
+
+		for i := 1; i <= 10; i++ {
+			assert.Nil(tt, bus.Publish("numbers", i))
+		}
+		assert.Nil(tt, bus.Shutdown(context.Background()))
+		assert.Equal(tt, 55, sum)
+
+		assert.ErrorIs(tt, bus.Publish("numbers", 1), ErrBusClosed)
+		assert.ErrorIs(tt, bus.Shutdown(context.Background()), ErrBusClosed)
+		_, err = bus.Subscribe("numbers", func(int) error { return nil })
+		assert.ErrorIs(tt, err, ErrBusClosed)
+	})
+
+	t.Run("drop when full", func(tt *testing.T) {
+		bus := NewBus[int]()
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var dropped []error
+		_, err := bus.SubscribeWith("numbers", func(x int) error {
+			if x == 1 {
+				close(started)
+			}
+			<-release
+			return nil
+		}, SubscribeConfig[int]{
+			Async:        true,
+			QueueSize:    1,
+			DropWhenFull: true,
+			ErrorHandler: func(err error) { dropped = append(dropped, err) },
+		})
+		assert.Nil(tt, err)
+
+		assert.Nil(tt, bus.Publish("numbers", 1))
+		<-started
+		assert.Nil(tt, bus.Publish("numbers", 2))
+		assert.Empty(tt, dropped)
+		assert.Nil(tt, bus.Publish("numbers", 3))
+		assert.Equal(tt, []error{ErrQueueFull}, dropped)
+
+		close(release)
+		assert.Nil(tt, bus.Shutdown(context.Background()))
+	})
+
+	t.Run("shutdown timeout", func(tt *testing.T) {
+		bus := NewBus[int]()
+		release := make(chan struct{})
+		_, err := bus.SubscribeWith("numbers", func(int) error {
+			<-release
+			return nil
+		}, SubscribeConfig[int]{Async: true, QueueSize: 1})
+		assert.Nil(tt, err)
+		assert.Nil(tt, bus.Publish("numbers", 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(tt, bus.Shutdown(ctx), context.DeadlineExceeded)
+		close(release)
+	})
+
+	t.Run("shutdown with blocked publisher", func(tt *testing.T) {
+		bus := NewBus[int]()
+		started := make(chan struct{})
+		release := make(chan struct{})
+		dropped := make(chan error, 1)
+		_, err := bus.SubscribeWith("numbers", func(x int) error {
+			if x == 1 {
+				close(started)
+			}
+			<-release
+			return nil
+		}, SubscribeConfig[int]{
+			Async:        true,
+			QueueSize:    1,
+			ErrorHandler: func(err error) { dropped <- err },
+		})
+		assert.Nil(tt, err)
+
+		assert.Nil(tt, bus.Publish("numbers", 1))
+		<-started
+		assert.Nil(tt, bus.Publish("numbers", 2))
+		published := make(chan struct{})
+		go func() {
+			_ = bus.Publish("numbers", 3)
+			close(published)
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		assert.ErrorIs(tt, bus.Shutdown(ctx), context.DeadlineExceeded)
+		assert.Less(tt, time.Since(start), 500*time.Millisecond)
+		<-published
+		assert.ErrorIs(tt, <-dropped, ErrSubscriptionClosed)
+		close(release)
+	})
+
+	t.Run("unsubscribe from consumer with full queue", func(tt *testing.T) {
+		bus := NewBus[int]()
+		var sub *Subscription[int]
+		unsubscribed := make(chan struct{})
+		started := make(chan struct{})
+		var err error
+		sub, err = bus.SubscribeWith("numbers", func(x int) error {
+			if x == 1 {
+				close(started)
+				time.Sleep(10 * time.Millisecond)
+				sub.Unsubscribe()
+				close(unsubscribed)
+			}
+			return nil
+		}, SubscribeConfig[int]{Async: true, QueueSize: 1})
+		assert.Nil(tt, err)
+
+		assert.Nil(tt, bus.Publish("numbers", 1))
+		<-started
+		assert.Nil(tt, bus.Publish("numbers", 2))
+		go func() { _ = bus.Publish("numbers", 3) }()
+
+		select {
+		case <-unsubscribed:
+		case <-time.After(time.Second):
+			tt.Fatal("Unsubscribe deadlocked")
+		}
+		assert.Equal(tt, 0, bus.SubscriberCount("numbers"))
+		assert.Nil(tt, bus.Shutdown(context.Background()))
+	})
+
+	t.Run("error handler calls are serialized", func(tt *testing.T) {
+		bus := NewBus[int]()
+		count := 0
+		_, err := bus.SubscribeWith("numbers", func(int) error {
+			return errors.New("failed")
+		}, SubscribeConfig[int]{
+			Async:        true,
+			QueueSize:    1,
+			DropWhenFull: true,
+			ErrorHandler: func(error) { count++ },
+		})
+		assert.Nil(tt, err)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					_ = bus.Publish("numbers", j)
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Nil(tt, bus.Shutdown(context.Background()))
+		assert.Equal(tt, 400, count)
+	})
+
+	t.Run("invalid subscriptions", func(tt *testing.T) {
+		bus := NewBus[int]()
+		_, err := bus.Subscribe("numbers", nil)
+		assert.ErrorIs(tt, err, ErrNilConsumer)
+		_, err = bus.SubscribeWith("numbers", func(int) error { return nil }, SubscribeConfig[int]{Async: true})
+		assert.ErrorIs(tt, err, ErrInvalidQueueSize)
+	})
+}
@@ -0,0 +1,132 @@
+package event
+
+import (
+	"sync"
+
+	"github.com/oculius/optio/fn"
+)
+
+type SubscribeConfig[T any] struct {
+	Filter       fn.SilentPredicate[T]
+	ErrorHandler fn.ErrorHandler
+
+	// Async subscribers receive events on their own goroutine through a queue
+	// of QueueSize events. When the queue is full, Publish blocks until there is
+	// room or the subscription is closed, unless DropWhenFull is set, in which
+	// case the event is dropped and ErrQueueFull is passed to the ErrorHandler.
+	// Events that can no longer be queued because the subscription was closed
+	// are dropped and ErrSubscriptionClosed is passed to the ErrorHandler.
+	// Calls to the ErrorHandler of a subscription never run concurrently.
+	Async        bool
+	QueueSize    int
+	DropWhenFull bool
+}
+
+type Subscription[T any] struct {
+	bus      *Bus[T]
+	topic    string
+	consumer fn.Consumer[T]
+	config   SubscribeConfig[T]
+
+	mu      sync.RWMutex
+	closed  bool
+	queue   chan T
+	done    chan struct{}
+	senders sync.WaitGroup
+	errMu   sync.Mutex
+}
+
+func (s *Subscription[T]) Topic() string {
+	return s.topic
+}
+
+func (s *Subscription[T]) Unsubscribe() {
+	if s.bus.remove(s) {
+		s.close()
+	}
+}
+
+func (s *Subscription[T]) deliver(event T) {
+	if s.config.Filter != nil && !s.config.Filter(event) {
+		return
+	}
+
+	if s.queue == nil {
+		s.consume(event)
+		return
+	}
+
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		s.handleError(ErrSubscriptionClosed)
+		return
+	}
+	s.senders.Add(1)
+	s.mu.RUnlock()
+
+	if err := s.send(event); err != nil {
+		s.handleError(err)
+	}
+}
+
+// send queues event without holding the lock. It is done before the error is
+// reported, so an ErrorHandler may close the subscription.
+func (s *Subscription[T]) send(event T) error {
+	defer s.senders.Done()
+
+	if !s.config.DropWhenFull {
+		select {
+		case s.queue <- event:
+			return nil
+		case <-s.done:
+			return ErrSubscriptionClosed
+		}
+	}
+
+	select {
+	case s.queue <- event:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (s *Subscription[T]) consume(event T) {
+	if err := s.consumer(event); err != nil {
+		s.handleError(err)
+	}
+}
+
+func (s *Subscription[T]) handleError(err error) {
+	if s.config.ErrorHandler != nil {
+		s.errMu.Lock()
+		defer s.errMu.Unlock()
+		s.config.ErrorHandler(err)
+	}
+}
+
+func (s *Subscription[T]) run(wg *sync.WaitGroup) {
+	defer wg.Done()
+	for event := range s.queue {
+		s.consume(event)
+	}
+}
+
+// close stops new sends, wakes publishers blocked on a full queue through done
+// and closes the queue once every publisher that was sending has returned.
+func (s *Subscription[T]) close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	s.mu.Unlock()
+
+	if s.queue != nil {
+		close(s.done)
+		s.senders.Wait()
+		close(s.queue)
+	}
+}
@@ -0,0 +1,33 @@
+package fn
+
+import "golang.org/x/exp/constraints"
+
+// Comparator returns a negative number when a < b, zero when a == b and a
+// positive number when a > b.
+type Comparator[T any] func(a, b T) int
+
+func NewNaturalComparator[T constraints.Ordered]() Comparator[T] {
+	return func(a, b T) int {
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+		return 0
+	}
+}
+
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+func (c Comparator[T]) ThenComparing(other Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if result := c(a, b); result != 0 {
+			return result
+		}
+		return other(a, b)
+	}
+}
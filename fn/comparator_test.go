@@ -0,0 +1,22 @@
+package fn
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestComparator(t *testing.T) {
+	natural := NewNaturalComparator[int]()
+
+	assert.Equal(t, -1, natural(1, 2))
+	assert.Equal(t, 0, natural(2, 2))
+	assert.Equal(t, 1, natural(3, 2))
+	assert.Equal(t, 1, natural.Reverse()(1, 2))
+
+	byLen := Comparator[string](func(a, b string) int { return len(a) - len(b) })
+	cmp := byLen.ThenComparing(NewNaturalComparator[string]())
+
+	assert.Less(t, cmp("b", "aa"), 0)
+	assert.Less(t, cmp("a", "b"), 0)
+	assert.Equal(t, 0, cmp("ab", "ab"))
+}
@@ -0,0 +1,62 @@
+package fn
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Equivalence defines equality for types that are not comparable or that need a
+// looser notion of equality. Values that are equal must have the same hash.
+type Equivalence[T any] struct {
+	Equals SilentBiPredicate[T, T]
+	Hash   func(T) uint64
+}
+
+func NewEquivalence[T any](equals SilentBiPredicate[T, T], hash func(T) uint64) Equivalence[T] {
+	return Equivalence[T]{Equals: equals, Hash: hash}
+}
+
+// NewNaturalEquivalence compares values with == and hashes them without
+// formatting. 0.0 and -0.0 share a hash; NaN is never equal to anything.
+func NewNaturalEquivalence[T constraints.Ordered]() Equivalence[T] {
+	return Equivalence[T]{
+		Equals: func(a, b T) bool { return a == b },
+		Hash:   HashOrdered[T],
+	}
+}
+
+// NewCaseInsensitiveEquivalence compares strings with Unicode case folding, like
+// strings.EqualFold.
+func NewCaseInsensitiveEquivalence() Equivalence[string] {
+	return Equivalence[string]{
+		Equals: strings.EqualFold,
+		Hash:   func(v string) uint64 { return HashString(foldString(v)) },
+	}
+}
+
+// NewKeyEquivalence compares values by the key returned by keyFn, using eq to
+// compare the keys.
+func NewKeyEquivalence[T, K any](keyFn func(T) K, eq Equivalence[K]) Equivalence[T] {
+	return Equivalence[T]{
+		Equals: func(a, b T) bool { return eq.Equals(keyFn(a), keyFn(b)) },
+		Hash:   func(v T) uint64 { return eq.Hash(keyFn(v)) },
+	}
+}
+
+// foldString maps every rune to the smallest rune of its case folding orbit.
+func foldString(s string) string {
+	buf := make([]byte, 0, len(s))
+	for _, r := range s {
+		folded := r
+		for c := unicode.SimpleFold(r); c != r; c = unicode.SimpleFold(c) {
+			if c < folded {
+				folded = c
+			}
+		}
+		buf = utf8.AppendRune(buf, folded)
+	}
+	return string(buf)
+}
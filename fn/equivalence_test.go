@@ -0,0 +1,64 @@
+package fn
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestEquivalence(t *testing.T) {
+	t.Run("natural", func(tt *testing.T) {
+		eq := NewNaturalEquivalence[int]()
+
+		assert.True(tt, eq.Equals(12, 12))
+		assert.False(tt, eq.Equals(12, 21))
+		assert.Equal(tt, eq.Hash(12), eq.Hash(12))
+		assert.NotEqual(tt, eq.Hash(12), eq.Hash(21))
+	})
+
+	t.Run("natural signed zero", func(tt *testing.T) {
+		eq := NewNaturalEquivalence[float64]()
+		negZero := math.Copysign(0, -1)
+
+		assert.True(tt, eq.Equals(0, negZero))
+		assert.Equal(tt, eq.Hash(0), eq.Hash(negZero))
+
+		type celsius float32
+		named := NewNaturalEquivalence[celsius]()
+		assert.Equal(tt, named.Hash(0), named.Hash(celsius(negZero)))
+		assert.Equal(tt, named.Hash(1.5), named.Hash(1.5))
+	})
+
+	t.Run("natural named string", func(tt *testing.T) {
+		type id string
+		eq := NewNaturalEquivalence[id]()
+
+		assert.Equal(tt, eq.Hash("abc"), eq.Hash(id("abc")))
+		assert.NotEqual(tt, eq.Hash("abc"), eq.Hash("abd"))
+	})
+
+	t.Run("case insensitive", func(tt *testing.T) {
+		eq := NewCaseInsensitiveEquivalence()
+		pairs := [][2]string{{"Hello", "hELLO"}, {"straße", "STRAßE"}, {"ſ", "S"}, {"K", "k"}}
+
+		for _, p := range pairs {
+			assert.True(tt, eq.Equals(p[0], p[1]), p[0])
+			assert.Equal(tt, eq.Hash(p[0]), eq.Hash(p[1]), p[0])
+		}
+		assert.False(tt, eq.Equals("hello", "world"))
+	})
+
+	t.Run("key", func(tt *testing.T) {
+		type user struct {
+			email string
+			roles []string
+		}
+		eq := NewKeyEquivalence(func(u user) string { return u.email }, NewCaseInsensitiveEquivalence())
+		a := user{"A@x.io", []string{"admin"}}
+		b := user{"a@X.io", nil}
+
+		assert.True(tt, eq.Equals(a, b))
+		assert.Equal(tt, eq.Hash(a), eq.Hash(b))
+		assert.False(tt, eq.Equals(a, user{email: "b@x.io"}))
+	})
+}
@@ -1,3 +1,28 @@
 package fn
 
+import (
+	"fmt"
+	"runtime/debug"
+)
+
 type ErrorHandler SilentConsumer[error]
+
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func NewPanicError(value any) *PanicError {
+	return &PanicError{Value: value, Stack: debug.Stack()}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("fn: recovered from panic: %v", e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
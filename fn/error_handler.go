@@ -0,0 +1,185 @@
+package fn
+
+import (
+	"errors"
+	"sync"
+)
+
+func (h ErrorHandler) AndThen(after ErrorHandler) ErrorHandler {
+	if after == nil {
+		return h
+	}
+
+	return func(err error) {
+		h(err)
+		after(err)
+	}
+}
+
+func (h ErrorHandler) Filter(pred SilentPredicate[error]) ErrorHandler {
+	return func(err error) {
+		if pred(err) {
+			h(err)
+		}
+	}
+}
+
+func NewEmptyErrorHandler() ErrorHandler {
+	return func(error) {}
+}
+
+func NewErrorHandlerChain(handlers ...ErrorHandler) ErrorHandler {
+	result := NewEmptyErrorHandler()
+	for _, h := range handlers {
+		if h != nil {
+			result = result.AndThen(h)
+		}
+	}
+	return result
+}
+
+func NewPanicErrorHandler() ErrorHandler {
+	return func(err error) {
+		panic(err)
+	}
+}
+
+// NewChannelErrorHandler forwards errors to ch, blocking while ch is full.
+func NewChannelErrorHandler(ch chan<- error) ErrorHandler {
+	return func(err error) {
+		ch <- err
+	}
+}
+
+// NewNonBlockingChannelErrorHandler forwards errors to ch, dropping them while
+// ch is full.
+func NewNonBlockingChannelErrorHandler(ch chan<- error) ErrorHandler {
+	return func(err error) {
+		select {
+		case ch <- err:
+		default:
+		}
+	}
+}
+
+func HandleErrorIs(target error, h ErrorHandler) ErrorHandler {
+	return func(err error) {
+		if errors.Is(err, target) {
+			h(err)
+		}
+	}
+}
+
+func HandleErrorAs[E error](h SilentConsumer[E]) ErrorHandler {
+	return func(err error) {
+		var target E
+		if errors.As(err, &target) {
+			h(target)
+		}
+	}
+}
+
+type ErrorCollector struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func NewErrorCollector() *ErrorCollector {
+	return &ErrorCollector{}
+}
+
+func (c *ErrorCollector) Handle(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errs = append(c.errs, err)
+}
+
+func (c *ErrorCollector) Handler() ErrorHandler {
+	return c.Handle
+}
+
+func (c *ErrorCollector) Errors() []error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.errs == nil {
+		return nil
+	}
+
+	result := make([]error, len(c.errs))
+	copy(result, c.errs)
+	return result
+}
+
+func (c *ErrorCollector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.errs)
+}
+
+func (c *ErrorCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errs = nil
+}
+
+// ErrorCounter counts errors by class using errors.Is. An error matching several
+// classes is counted once for each of them, and an error matching none of them
+// is counted as unclassified.
+type ErrorCounter struct {
+	mu           sync.Mutex
+	classes      []error
+	counts       []int
+	unclassified int
+	total        int
+}
+
+func NewErrorCounter(classes ...error) *ErrorCounter {
+	return &ErrorCounter{
+		classes: classes,
+		counts:  make([]int, len(classes)),
+	}
+}
+
+func (c *ErrorCounter) Handle(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.total++
+	matched := false
+	for i, class := range c.classes {
+		if errors.Is(err, class) {
+			c.counts[i]++
+			matched = true
+		}
+	}
+	if !matched {
+		c.unclassified++
+	}
+}
+
+func (c *ErrorCounter) Handler() ErrorHandler {
+	return c.Handle
+}
+
+func (c *ErrorCounter) Count(class error) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range c.classes {
+		if c.classes[i] == class {
+			return c.counts[i]
+		}
+	}
+	return 0
+}
+
+func (c *ErrorCounter) Unclassified() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.unclassified
+}
+
+func (c *ErrorCounter) Total() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.total
+}
@@ -0,0 +1,21 @@
+//go:build go1.21
+
+package fn
+
+import (
+	"context"
+	"log/slog"
+)
+
+func NewSlogErrorHandler(logger *slog.Logger, level slog.Level, msg string, attrs ...slog.Attr) ErrorHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(err error) {
+		args := make([]slog.Attr, 0, len(attrs)+1)
+		args = append(args, attrs...)
+		args = append(args, slog.Any("error", err))
+		logger.LogAttrs(context.Background(), level, msg, args...)
+	}
+}
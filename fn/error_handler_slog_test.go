@@ -0,0 +1,24 @@
+//go:build go1.21
+
+package fn
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"testing"
+)
+
+func TestSlogErrorHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	h := NewSlogErrorHandler(logger, slog.LevelWarn, "request failed", slog.String("component", "ingest"))
+
+	h(errors.New("some error occured"))
+
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), `msg="request failed"`)
+	assert.Contains(t, buf.String(), "component=ingest")
+	assert.Contains(t, buf.String(), `error="some error occured"`)
+}
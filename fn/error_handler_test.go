@@ -0,0 +1,120 @@
+package fn
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestErrorHandler(t *testing.T) {
+	someError := errors.New("some error occured")
+	otherError := errors.New("other error occured")
+
+	t.Run("and then", func(tt *testing.T) {
+		var calls []string
+		h := ErrorHandler(func(error) { calls = append(calls, "first") }).
+			AndThen(func(error) { calls = append(calls, "second") }).
+			AndThen(nil)
+		h(someError)
+
+		assert.Equal(tt, []string{"first", "second"}, calls)
+	})
+
+	t.Run("chain and filter", func(tt *testing.T) {
+		collector := NewErrorCollector()
+		h := NewErrorHandlerChain(nil, collector.Handler(), collector.Handler()).
+			Filter(func(err error) bool { return err != otherError })
+		h(someError)
+		h(otherError)
+
+		assert.Equal(tt, []error{someError, someError}, collector.Errors())
+	})
+
+	t.Run("errors is", func(tt *testing.T) {
+		collector := NewErrorCollector()
+		h := HandleErrorIs(someError, collector.Handle)
+		h(fmt.Errorf("wrapped: %w", someError))
+		h(otherError)
+
+		assert.Equal(tt, 1, collector.Len())
+	})
+
+	t.Run("errors as", func(tt *testing.T) {
+		var codes []int
+		h := HandleErrorAs(func(err *codeError) { codes = append(codes, err.code) })
+		h(fmt.Errorf("wrapped: %w", &codeError{404}))
+		h(someError)
+		h(&codeError{500})
+
+		assert.Equal(tt, []int{404, 500}, codes)
+	})
+
+	t.Run("panic", func(tt *testing.T) {
+		assert.PanicsWithValue(tt, someError, func() { NewPanicErrorHandler()(someError) })
+	})
+
+	t.Run("channel", func(tt *testing.T) {
+		ch := make(chan error, 1)
+		NewChannelErrorHandler(ch)(someError)
+		NewNonBlockingChannelErrorHandler(ch)(otherError)
+
+		assert.Equal(tt, someError, <-ch)
+		assert.Len(tt, ch, 0)
+	})
+
+	t.Run("to silent consumer", func(tt *testing.T) {
+		collector := NewErrorCollector()
+		consumer := Consumer[int](func(int) error { return someError })
+		consumer.ToSilentConsumer(collector.Handler())(1)
+
+		assert.Equal(tt, []error{someError}, collector.Errors())
+	})
+}
+
+func TestErrorCollector(t *testing.T) {
+	collector := NewErrorCollector()
+	assert.Nil(t, collector.Errors())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			collector.Handle(&codeError{i})
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, collector.Len())
+	assert.Len(t, collector.Errors(), 50)
+	collector.Reset()
+	assert.Equal(t, 0, collector.Len())
+}
+
+func TestErrorCounter(t *testing.T) {
+	someError := errors.New("some error occured")
+	otherError := errors.New("other error occured")
+	counter := NewErrorCounter(someError, otherError)
+	h := counter.Handler()
+
+	h(someError)
+	h(fmt.Errorf("wrapped: %w", someError))
+	h(otherError)
+	h(errors.New("unknown"))
+
+	assert.Equal(t, 2, counter.Count(someError))
+	assert.Equal(t, 1, counter.Count(otherError))
+	assert.Equal(t, 0, counter.Count(errors.New("not a class")))
+	assert.Equal(t, 1, counter.Unclassified())
+	assert.Equal(t, 4, counter.Total())
+}
@@ -0,0 +1,127 @@
+package fn
+
+import (
+	"math"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Mix64 is the finalizer of SplitMix64. It spreads x over all 64 bits.
+func Mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// HashString hashes s with FNV-1a, inlined to avoid allocating a hash.Hash per
+// call, and mixes the result with Mix64.
+func HashString(s string) uint64 {
+	return Mix64(fnv1a(s))
+}
+
+func HashBytes(b []byte) uint64 {
+	h := uint64(14695981039346656037)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= 1099511628211
+	}
+	return Mix64(h)
+}
+
+// HashOrdered hashes numbers and strings without formatting them. 0.0 and -0.0
+// share a hash.
+func HashOrdered[T constraints.Ordered](v T) uint64 {
+	switch x := any(v).(type) {
+	case int:
+		return Mix64(uint64(x))
+	case int64:
+		return Mix64(uint64(x))
+	case uint64:
+		return Mix64(x)
+	case string:
+		return HashString(x)
+	case float64:
+		return Mix64(floatBits(x))
+	}
+	return hashValue(0, reflect.ValueOf(v))
+}
+
+// HashComparable hashes any comparable value so that values equal under ==
+// share a hash: structs and arrays are hashed field by field, pointers and
+// channels by address and interfaces by their dynamic value.
+func HashComparable[T comparable](v T) uint64 {
+	switch x := any(v).(type) {
+	case int:
+		return Mix64(uint64(x))
+	case string:
+		return HashString(x)
+	}
+	return hashValue(0, reflect.ValueOf(&v).Elem())
+}
+
+func fnv1a(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+// floatBits maps -0.0 to the bits of 0.0, as they compare equal.
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// hashValue mixes seed with v, so hashValue(0, v) matches the fast paths of
+// HashOrdered and HashComparable.
+
+func hashValue(seed uint64, v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return Mix64(seed ^ 1)
+		}
+		return Mix64(seed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Mix64(seed ^ uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Mix64(seed ^ v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return Mix64(seed ^ floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return Mix64(Mix64(seed^floatBits(real(c))) ^ floatBits(imag(c)))
+	case reflect.String:
+		return Mix64(seed ^ fnv1a(v.String()))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return Mix64(seed ^ uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			seed = hashValue(seed, v.Index(i))
+		}
+		return seed
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).Name != "_" {
+				seed = hashValue(seed, v.Field(i))
+			}
+		}
+		return seed
+	case reflect.Interface:
+		if v.IsNil() {
+			return Mix64(seed)
+		}
+		return hashValue(seed, v.Elem())
+	default:
+		return Mix64(seed)
+	}
+}
@@ -0,0 +1,44 @@
+package fn
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestHashComparable(t *testing.T) {
+	type point struct {
+		X, Y float64
+		Ref  *int
+		Grid [2]float64
+		_    int
+	}
+	negZero := math.Copysign(0, -1)
+
+	t.Run("equal values share a hash", func(tt *testing.T) {
+		x, y := 1, 1
+		pairs := [][2]point{
+			{{X: 0, Y: 1}, {X: negZero, Y: 1}},
+			{{Ref: &x}, {Ref: &x}},
+			{{Grid: [2]float64{0, 1}}, {Grid: [2]float64{negZero, 1}}},
+		}
+		for _, p := range pairs {
+			assert.True(tt, p[0] == p[1])
+			assert.Equal(tt, HashComparable(p[0]), HashComparable(p[1]))
+		}
+		assert.NotEqual(tt, HashComparable(point{Ref: &x}), HashComparable(point{Ref: &y}))
+		assert.NotEqual(tt, HashComparable(point{X: 1, Y: 2}), HashComparable(point{X: 2, Y: 1}))
+	})
+
+	t.Run("matches HashOrdered", func(tt *testing.T) {
+		type id string
+		type celsius float64
+
+		assert.Equal(tt, HashOrdered("abc"), HashComparable("abc"))
+		assert.Equal(tt, HashOrdered("abc"), HashOrdered(id("abc")))
+		assert.Equal(tt, HashOrdered(1.5), HashOrdered(celsius(1.5)))
+		assert.Equal(tt, HashOrdered(7), HashComparable(7))
+		assert.Equal(tt, HashOrdered(int8(7)), HashComparable(int8(7)))
+		assert.Equal(tt, HashString("abc"), HashBytes([]byte("abc")))
+	})
+}
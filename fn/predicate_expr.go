@@ -0,0 +1,436 @@
+package fn
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+type exprOp uint8
+
+const (
+	exprConst exprOp = iota
+	exprLeaf
+	exprNot
+	exprAnd
+	exprOr
+	exprXor
+	exprXnor
+)
+
+const (
+	DefaultPredicateCost        = 1.0
+	DefaultPredicateSelectivity = 0.5
+)
+
+var exprLeafID uint64
+
+type PredicateExpr[T any] struct {
+	op          exprOp
+	id          uint64
+	value       bool
+	pred        SilentPredicate[T]
+	cost        float64
+	selectivity float64
+	children    []*PredicateExpr[T]
+}
+
+var _ GenericPredicate[*PredicateExpr[int]] = NewPredicateExpr[int](
+	func(int) bool { return true },
+)
+
+func NewPredicateExpr[T any](pred SilentPredicate[T]) *PredicateExpr[T] {
+	return NewWeightedPredicateExpr(pred, DefaultPredicateCost, DefaultPredicateSelectivity)
+}
+
+// NewWeightedPredicateExpr creates a leaf with an estimated evaluation cost and
+// selectivity, the probability that pred returns true. Both are used by Reorder.
+func NewWeightedPredicateExpr[T any](pred SilentPredicate[T], cost, selectivity float64) *PredicateExpr[T] {
+	if cost < 0 {
+		cost = 0
+	}
+	if selectivity < 0 {
+		selectivity = 0
+	} else if selectivity > 1 {
+		selectivity = 1
+	}
+
+	return &PredicateExpr[T]{
+		op:          exprLeaf,
+		id:          atomic.AddUint64(&exprLeafID, 1),
+		pred:        pred,
+		cost:        cost,
+		selectivity: selectivity,
+	}
+}
+
+func NewConstPredicateExpr[T any](value bool) *PredicateExpr[T] {
+	return &PredicateExpr[T]{op: exprConst, value: value}
+}
+
+func (e *PredicateExpr[T]) Negate() *PredicateExpr[T] {
+	return &PredicateExpr[T]{op: exprNot, children: []*PredicateExpr[T]{e}}
+}
+
+func (e *PredicateExpr[T]) And(other *PredicateExpr[T]) *PredicateExpr[T] {
+	return &PredicateExpr[T]{op: exprAnd, children: []*PredicateExpr[T]{e, other}}
+}
+
+func (e *PredicateExpr[T]) Or(other *PredicateExpr[T]) *PredicateExpr[T] {
+	return &PredicateExpr[T]{op: exprOr, children: []*PredicateExpr[T]{e, other}}
+}
+
+func (e *PredicateExpr[T]) Xor(other *PredicateExpr[T]) *PredicateExpr[T] {
+	return &PredicateExpr[T]{op: exprXor, children: []*PredicateExpr[T]{e, other}}
+}
+
+func (e *PredicateExpr[T]) Xnor(other *PredicateExpr[T]) *PredicateExpr[T] {
+	return &PredicateExpr[T]{op: exprXnor, children: []*PredicateExpr[T]{e, other}}
+}
+
+func (e *PredicateExpr[T]) Eval(v T) bool {
+	switch e.op {
+	case exprConst:
+		return e.value
+	case exprLeaf:
+		return e.pred(v)
+	case exprNot:
+		return !e.children[0].Eval(v)
+	case exprAnd:
+		for _, c := range e.children {
+			if !c.Eval(v) {
+				return false
+			}
+		}
+		return true
+	case exprOr:
+		for _, c := range e.children {
+			if c.Eval(v) {
+				return true
+			}
+		}
+		return false
+	case exprXor:
+		return e.children[0].Eval(v) != e.children[1].Eval(v)
+	default:
+		return e.children[0].Eval(v) == e.children[1].Eval(v)
+	}
+}
+
+func (e *PredicateExpr[T]) Compile() SilentPredicate[T] {
+	switch e.op {
+	case exprConst:
+		value := e.value
+		return func(T) bool { return value }
+	case exprLeaf:
+		return e.pred
+	case exprNot:
+		return e.children[0].Compile().Negate()
+	case exprAnd, exprOr:
+		preds := make([]SilentPredicate[T], len(e.children))
+		for i, c := range e.children {
+			preds[i] = c.Compile()
+		}
+		if e.op == exprAnd {
+			return func(v T) bool {
+				for _, p := range preds {
+					if !p(v) {
+						return false
+					}
+				}
+				return true
+			}
+		}
+		return func(v T) bool {
+			for _, p := range preds {
+				if p(v) {
+					return true
+				}
+			}
+			return false
+		}
+	case exprXor:
+		return e.children[0].Compile().Xor(e.children[1].Compile())
+	default:
+		return e.children[0].Compile().Xnor(e.children[1].Compile())
+	}
+}
+
+// Simplify returns an equivalent expression with negations pushed down to the
+// leaves, nested And/Or flattened, constants folded and duplicate operands
+// removed. Leaves are compared by identity, so reusing the same leaf in several
+// places is what allows it to be deduplicated.
+func (e *PredicateExpr[T]) Simplify() *PredicateExpr[T] {
+	return e.simplify(false)
+}
+
+func (e *PredicateExpr[T]) simplify(negate bool) *PredicateExpr[T] {
+	switch e.op {
+	case exprConst:
+		return NewConstPredicateExpr[T](e.value != negate)
+	case exprLeaf:
+		if negate {
+			return e.Negate()
+		}
+		return e
+	case exprNot:
+		return e.children[0].simplify(!negate)
+	case exprAnd, exprOr:
+		op := e.op
+		if negate {
+			if op == exprAnd {
+				op = exprOr
+			} else {
+				op = exprAnd
+			}
+		}
+		children := make([]*PredicateExpr[T], 0, len(e.children))
+		for _, c := range e.children {
+			children = append(children, c.simplify(negate))
+		}
+		return foldJunction(op, children)
+	default:
+		op := e.op
+		if negate {
+			if op == exprXor {
+				op = exprXnor
+			} else {
+				op = exprXor
+			}
+		}
+		return foldParity(op, e.children[0].simplify(false), e.children[1].simplify(false))
+	}
+}
+
+func foldJunction[T any](op exprOp, children []*PredicateExpr[T]) *PredicateExpr[T] {
+	absorbing := op == exprOr
+	result := make([]*PredicateExpr[T], 0, len(children))
+	var flatten func(c *PredicateExpr[T]) bool
+	flatten = func(c *PredicateExpr[T]) bool {
+		switch {
+		case c.op == exprConst:
+			return c.value == absorbing
+		case c.op == op:
+			for _, cc := range c.children {
+				if flatten(cc) {
+					return true
+				}
+			}
+			return false
+		}
+
+		for _, r := range result {
+			if r.equal(c) {
+				return false
+			}
+			if r.complements(c) {
+				return true
+			}
+		}
+		result = append(result, c)
+		return false
+	}
+
+	for _, c := range children {
+		if flatten(c) {
+			return NewConstPredicateExpr[T](absorbing)
+		}
+	}
+
+	switch len(result) {
+	case 0:
+		return NewConstPredicateExpr[T](!absorbing)
+	case 1:
+		return result[0]
+	}
+	return &PredicateExpr[T]{op: op, children: result}
+}
+
+func foldParity[T any](op exprOp, left, right *PredicateExpr[T]) *PredicateExpr[T] {
+	if left.op != exprConst && right.op == exprConst {
+		left, right = right, left
+	}
+
+	xnor := op == exprXnor
+	switch {
+	case left.op == exprConst && right.op == exprConst:
+		return NewConstPredicateExpr[T]((left.value == right.value) == xnor)
+	case left.op == exprConst:
+		return right.simplify(left.value != xnor)
+	case left.equal(right):
+		return NewConstPredicateExpr[T](xnor)
+	case left.complements(right):
+		return NewConstPredicateExpr[T](!xnor)
+	}
+	return &PredicateExpr[T]{op: op, children: []*PredicateExpr[T]{left, right}}
+}
+
+func (e *PredicateExpr[T]) equal(other *PredicateExpr[T]) bool {
+	if e == other {
+		return true
+	}
+	if e.op != other.op || len(e.children) != len(other.children) {
+		return false
+	}
+
+	switch e.op {
+	case exprConst:
+		return e.value == other.value
+	case exprLeaf:
+		return e.id == other.id
+	}
+
+	for i := range e.children {
+		if !e.children[i].equal(other.children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *PredicateExpr[T]) complements(other *PredicateExpr[T]) bool {
+	if e.op == exprNot {
+		return e.children[0].equal(other)
+	}
+	if other.op == exprNot {
+		return other.children[0].equal(e)
+	}
+	return false
+}
+
+// Cost returns the expected evaluation cost of the expression, taking the
+// short-circuiting of And and Or in their current operand order into account.
+func (e *PredicateExpr[T]) Cost() float64 {
+	switch e.op {
+	case exprConst:
+		return 0
+	case exprLeaf:
+		return e.cost
+	case exprNot:
+		return e.children[0].Cost()
+	case exprAnd, exprOr:
+		total := 0.0
+		reach := 1.0
+		for _, c := range e.children {
+			total += reach * c.Cost()
+			if e.op == exprAnd {
+				reach *= c.Selectivity()
+			} else {
+				reach *= 1 - c.Selectivity()
+			}
+		}
+		return total
+	default:
+		return e.children[0].Cost() + e.children[1].Cost()
+	}
+}
+
+// Selectivity returns the estimated probability that the expression holds,
+// assuming the leaves are independent.
+func (e *PredicateExpr[T]) Selectivity() float64 {
+	switch e.op {
+	case exprConst:
+		if e.value {
+			return 1
+		}
+		return 0
+	case exprLeaf:
+		return e.selectivity
+	case exprNot:
+		return 1 - e.children[0].Selectivity()
+	case exprAnd:
+		result := 1.0
+		for _, c := range e.children {
+			result *= c.Selectivity()
+		}
+		return result
+	case exprOr:
+		miss := 1.0
+		for _, c := range e.children {
+			miss *= 1 - c.Selectivity()
+		}
+		return 1 - miss
+	default:
+		left, right := e.children[0].Selectivity(), e.children[1].Selectivity()
+		xor := left*(1-right) + (1-left)*right
+		if e.op == exprXor {
+			return xor
+		}
+		return 1 - xor
+	}
+}
+
+// Reorder returns an equivalent expression whose And and Or operands are sorted
+// so that cheap operands likely to short-circuit are evaluated first.
+func (e *PredicateExpr[T]) Reorder() *PredicateExpr[T] {
+	if len(e.children) == 0 {
+		return e
+	}
+
+	children := make([]*PredicateExpr[T], len(e.children))
+	for i, c := range e.children {
+		children[i] = c.Reorder()
+	}
+	if e.op == exprAnd || e.op == exprOr {
+		rank := make([]float64, len(children))
+		for i, c := range children {
+			stop := 1 - c.Selectivity()
+			if e.op == exprOr {
+				stop = c.Selectivity()
+			}
+			if stop <= 0 {
+				rank[i] = math.Inf(1)
+			} else {
+				rank[i] = c.Cost() / stop
+			}
+		}
+		sort.Stable(exprRank[T]{children, rank})
+	}
+	return &PredicateExpr[T]{op: e.op, children: children}
+}
+
+type exprRank[T any] struct {
+	children []*PredicateExpr[T]
+	rank     []float64
+}
+
+func (r exprRank[T]) Len() int           { return len(r.children) }
+func (r exprRank[T]) Less(i, j int) bool { return r.rank[i] < r.rank[j] }
+func (r exprRank[T]) Swap(i, j int) {
+	r.children[i], r.children[j] = r.children[j], r.children[i]
+	r.rank[i], r.rank[j] = r.rank[j], r.rank[i]
+}
+
+func (e *PredicateExpr[T]) String() string {
+	var sb strings.Builder
+	e.write(&sb)
+	return sb.String()
+}
+
+func (e *PredicateExpr[T]) write(sb *strings.Builder) {
+	switch e.op {
+	case exprConst:
+		sb.WriteString(strconv.FormatBool(e.value))
+		return
+	case exprLeaf:
+		sb.WriteString("p")
+		sb.WriteString(strconv.FormatUint(e.id, 10))
+		return
+	case exprNot:
+		sb.WriteString("!")
+		e.children[0].write(sb)
+		return
+	}
+
+	sep := map[exprOp]string{exprAnd: " & ", exprOr: " | ", exprXor: " ^ ", exprXnor: " == "}[e.op]
+	sb.WriteString("(")
+	for i, c := range e.children {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		c.write(sb)
+	}
+	sb.WriteString(")")
+}
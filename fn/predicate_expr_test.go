@@ -0,0 +1,128 @@
+package fn
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPredicateExpr(t *testing.T) {
+	even := NewPredicateExpr(func(x int) bool { return x%2 == 0 })
+	positive := NewPredicateExpr(func(x int) bool { return x > 0 })
+	small := NewPredicateExpr(func(x int) bool { return x < 10 })
+	values := []int{-4, -3, 0, 1, 2, 9, 10, 11, 12}
+
+	equivalent := func(tt *testing.T, expected, actual *PredicateExpr[int]) {
+		compiled := actual.Compile()
+		for _, v := range values {
+			assert.Equal(tt, expected.Eval(v), actual.Eval(v), "value %d", v)
+			assert.Equal(tt, expected.Eval(v), compiled(v), "value %d", v)
+		}
+	}
+
+	t.Run("compile matches closure combinators", func(tt *testing.T) {
+		expr := even.And(positive).Or(small.Negate()).Xor(even.Xnor(small))
+		closure := even.pred.And(positive.pred).Or(small.pred.Negate()).Xor(even.pred.Xnor(small.pred))
+
+		compiled := expr.Compile()
+		for _, v := range values {
+			assert.Equal(tt, closure(v), compiled(v), "value %d", v)
+		}
+	})
+
+	t.Run("double negation", func(tt *testing.T) {
+		expr := even.Negate().Negate()
+		simplified := expr.Simplify()
+
+		assert.Same(tt, even, simplified)
+		equivalent(tt, expr, simplified)
+	})
+
+	t.Run("de morgan", func(tt *testing.T) {
+		expr := even.And(positive).Negate()
+		simplified := expr.Simplify()
+
+		assert.Equal(tt, even.Negate().Or(positive.Negate()).String(), simplified.String())
+		equivalent(tt, expr, simplified)
+
+		expr = even.Or(positive).Negate()
+		simplified = expr.Simplify()
+
+		assert.Equal(tt, even.Negate().And(positive.Negate()).String(), simplified.String())
+		equivalent(tt, expr, simplified)
+	})
+
+	t.Run("constant folding", func(tt *testing.T) {
+		yes := NewConstPredicateExpr[int](true)
+		no := NewConstPredicateExpr[int](false)
+
+		assert.Same(tt, even, even.And(yes).Simplify())
+		assert.Equal(tt, "false", even.And(no).Simplify().String())
+		assert.Equal(tt, "true", even.Or(yes).Simplify().String())
+		assert.Same(tt, even, even.Or(no).Simplify())
+		assert.Same(tt, even, even.Xor(no).Simplify())
+		assert.Equal(tt, even.Negate().String(), even.Xor(yes).Simplify().String())
+		assert.Same(tt, even, even.Xnor(yes).Simplify())
+		assert.Equal(tt, "false", yes.Xnor(no).Simplify().String())
+		assert.Equal(tt, "true", no.Negate().Simplify().String())
+	})
+
+	t.Run("duplicate leaf elimination", func(tt *testing.T) {
+		expr := even.And(positive).And(even).And(positive)
+		simplified := expr.Simplify()
+
+		assert.Equal(tt, "("+even.String()+" & "+positive.String()+")", simplified.String())
+		equivalent(tt, expr, simplified)
+
+		assert.Equal(tt, "false", even.And(even.Negate()).Simplify().String())
+		assert.Equal(tt, "true", even.Or(even.Negate()).Simplify().String())
+		assert.Equal(tt, "false", even.Xor(even).Simplify().String())
+		assert.Equal(tt, "true", even.Xnor(even.Negate().Negate()).Simplify().String())
+	})
+
+	t.Run("negated parity", func(tt *testing.T) {
+		expr := even.Xor(positive).Negate()
+		simplified := expr.Simplify()
+
+		assert.Equal(tt, even.Xnor(positive).String(), simplified.String())
+		equivalent(tt, expr, simplified)
+	})
+
+	t.Run("reorder by cost and selectivity", func(tt *testing.T) {
+		count := 0
+		expensive := NewWeightedPredicateExpr(func(x int) bool {
+			count++
+			return x > 0
+		}, 100, 0.5)
+		rare := NewWeightedPredicateExpr(func(x int) bool { return x == 2 }, 1, 0.01)
+
+		expr := expensive.And(rare)
+		reordered := expr.Reorder()
+
+		assert.Equal(tt, "("+rare.String()+" & "+expensive.String()+")", reordered.String())
+		assert.Less(tt, reordered.Cost(), expr.Cost())
+		equivalent(tt, expr, reordered)
+
+		count = 0
+		compiled := reordered.Compile()
+		for _, v := range values {
+			compiled(v)
+		}
+		assert.Equal(tt, 1, count)
+
+		expr = expensive.Or(rare.Negate())
+		reordered = expr.Reorder()
+
+		assert.Equal(tt, "("+rare.Negate().String()+" | "+expensive.String()+")", reordered.String())
+		equivalent(tt, expr, reordered)
+	})
+
+	t.Run("selectivity", func(tt *testing.T) {
+		a := NewWeightedPredicateExpr(func(int) bool { return true }, 1, 0.2)
+		b := NewWeightedPredicateExpr(func(int) bool { return true }, 1, 0.5)
+
+		assert.InDelta(tt, 0.1, a.And(b).Selectivity(), 1e-9)
+		assert.InDelta(tt, 0.6, a.Or(b).Selectivity(), 1e-9)
+		assert.InDelta(tt, 0.8, a.Negate().Selectivity(), 1e-9)
+		assert.InDelta(tt, 0.5, a.Xor(b).Selectivity(), 1e-9)
+	})
+}
@@ -0,0 +1,94 @@
+package fn
+
+func recoverPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = NewPanicError(r)
+	}
+}
+
+func (c SilentConsumer[T]) Recover() Consumer[T] {
+	return func(v1 T) (err error) {
+		defer recoverPanic(&err)
+		c(v1)
+		return nil
+	}
+}
+
+func (c Consumer[T]) Recover() Consumer[T] {
+	return func(v1 T) (err error) {
+		defer recoverPanic(&err)
+		return c(v1)
+	}
+}
+
+func (bc SilentBiConsumer[T, V]) Recover() BiConsumer[T, V] {
+	return func(v1 T, v2 V) (err error) {
+		defer recoverPanic(&err)
+		bc(v1, v2)
+		return nil
+	}
+}
+
+func (bc BiConsumer[T, V]) Recover() BiConsumer[T, V] {
+	return func(v1 T, v2 V) (err error) {
+		defer recoverPanic(&err)
+		return bc(v1, v2)
+	}
+}
+
+func (p SilentPredicate[T]) Recover() Predicate[T] {
+	return func(v1 T) (result bool, err error) {
+		defer recoverPanic(&err)
+		return p(v1), nil
+	}
+}
+
+func (p Predicate[T]) Recover() Predicate[T] {
+	return func(v1 T) (result bool, err error) {
+		defer recoverPanic(&err)
+		return p(v1)
+	}
+}
+
+func (p SilentBiPredicate[T, V]) Recover() BiPredicate[T, V] {
+	return func(v1 T, v2 V) (result bool, err error) {
+		defer recoverPanic(&err)
+		return p(v1, v2), nil
+	}
+}
+
+func (p BiPredicate[T, V]) Recover() BiPredicate[T, V] {
+	return func(v1 T, v2 V) (result bool, err error) {
+		defer recoverPanic(&err)
+		return p(v1, v2)
+	}
+}
+
+func (s SilentSupplier[T]) Recover() Supplier[T] {
+	return func() (result T, err error) {
+		defer recoverPanic(&err)
+		return s(), nil
+	}
+}
+
+func (s Supplier[T]) Recover() Supplier[T] {
+	return func() (result T, err error) {
+		defer recoverPanic(&err)
+		return s()
+	}
+}
+
+func (s SilentBiSupplier[T, V]) Recover() BiSupplier[T, V] {
+	return func() (r1 T, r2 V, err error) {
+		defer recoverPanic(&err)
+		r1, r2 = s()
+		return r1, r2, nil
+	}
+}
+
+func (s BiSupplier[T, V]) Recover() BiSupplier[T, V] {
+	return func() (r1 T, r2 V, err error) {
+		defer recoverPanic(&err)
+		return s()
+	}
+}
@@ -0,0 +1,81 @@
+package fn
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRecover(t *testing.T) {
+	someError := errors.New("some error occured")
+
+	assertPanicError := func(tt *testing.T, err error, value any) {
+		var panicErr *PanicError
+		assert.True(tt, errors.As(err, &panicErr))
+		assert.Equal(tt, value, panicErr.Value)
+		assert.NotEmpty(tt, panicErr.Stack)
+	}
+
+	t.Run("consumer", func(tt *testing.T) {
+		err := SilentConsumer[int](func(int) { panic("boom") }).Recover()(1)
+		assertPanicError(tt, err, "boom")
+
+		err = SilentConsumer[int](func(int) {}).Recover()(1)
+		assert.Nil(tt, err)
+
+		err = Consumer[int](func(int) error { return someError }).Recover()(1)
+		assert.Equal(tt, someError, err)
+
+		err = Consumer[int](func(int) error { panic(someError) }).Recover()(1)
+		assertPanicError(tt, err, someError)
+		assert.True(tt, errors.Is(err, someError))
+	})
+
+	t.Run("bi consumer", func(tt *testing.T) {
+		err := SilentBiConsumer[int, string](func(int, string) { panic(1) }).Recover()(1, "")
+		assertPanicError(tt, err, 1)
+
+		err = BiConsumer[int, string](func(int, string) error { panic(2) }).Recover()(1, "")
+		assertPanicError(tt, err, 2)
+	})
+
+	t.Run("predicate", func(tt *testing.T) {
+		result, err := SilentPredicate[int](func(x int) bool { return x > 0 }).Recover()(1)
+		assert.True(tt, result)
+		assert.Nil(tt, err)
+
+		result, err = SilentPredicate[int](func(int) bool { panic("boom") }).Recover()(1)
+		assert.False(tt, result)
+		assertPanicError(tt, err, "boom")
+
+		result, err = Predicate[int](func(int) (bool, error) { panic("boom") }).Recover()(1)
+		assert.False(tt, result)
+		assertPanicError(tt, err, "boom")
+	})
+
+	t.Run("bi predicate", func(tt *testing.T) {
+		_, err := SilentBiPredicate[int, int](func(int, int) bool { panic("boom") }).Recover()(1, 2)
+		assertPanicError(tt, err, "boom")
+
+		_, err = BiPredicate[int, int](func(int, int) (bool, error) { panic("boom") }).Recover()(1, 2)
+		assertPanicError(tt, err, "boom")
+	})
+
+	t.Run("supplier", func(tt *testing.T) {
+		value, err := SilentSupplier[int](func() int { return 5 }).Recover()()
+		assert.Equal(tt, 5, value)
+		assert.Nil(tt, err)
+
+		value, err = Supplier[int](func() (int, error) { panic("boom") }).Recover()()
+		assert.Zero(tt, value)
+		assertPanicError(tt, err, "boom")
+
+		_, _, err = SilentBiSupplier[int, string](func() (int, string) { panic("boom") }).Recover()()
+		assertPanicError(tt, err, "boom")
+
+		v1, v2, err := BiSupplier[int, string](func() (int, string, error) { return 1, "a", nil }).Recover()()
+		assert.Equal(tt, 1, v1)
+		assert.Equal(tt, "a", v2)
+		assert.Nil(tt, err)
+	})
+}
@@ -0,0 +1,33 @@
+package graph
+
+import "github.com/oculius/optio/iterator"
+
+// NewComponentsIter yields the connected components of the graph made of nodes
+// and every node reachable from them, ignoring edge direction. Components are
+// ordered by their first node in nodes, and their nodes by discovery order.
+func NewComponentsIter[T comparable](nodes []T, neighbors ChildrenFunc[T]) iterator.IIterator[[]T] {
+	var order []T
+	adjacency := map[T][]T{}
+	for _, node := range NewDFSPreOrderIter(nodes, neighbors).Collect() {
+		order = append(order, node)
+		for _, next := range neighbors(node) {
+			adjacency[node] = append(adjacency[node], next)
+			adjacency[next] = append(adjacency[next], node)
+		}
+	}
+
+	var result [][]T
+	visited := map[T]struct{}{}
+	for _, node := range order {
+		if _, ok := visited[node]; ok {
+			continue
+		}
+
+		component := NewBFSIter([]T{node}, func(n T) []T { return adjacency[n] }).Collect()
+		for _, n := range component {
+			visited[n] = struct{}{}
+		}
+		result = append(result, component)
+	}
+	return iterator.NewIterator(result)
+}
@@ -0,0 +1,215 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/oculius/optio/iterator"
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	ErrNodeNotFound   = errors.New("graph: node not found")
+	ErrNoPath         = errors.New("graph: no path between nodes")
+	ErrNegativeWeight = errors.New("graph: negative edge weight")
+	ErrUndirected     = errors.New("graph: graph is undirected")
+)
+
+type Weight interface {
+	constraints.Integer | constraints.Float
+}
+
+type Edge[T comparable, W Weight] struct {
+	From   T
+	To     T
+	Weight W
+}
+
+// Graph is a weighted graph keeping nodes and edges in insertion order.
+type Graph[T comparable, W Weight] struct {
+	directed bool
+	nodes    []T
+	edges    map[T][]Edge[T, W]
+}
+
+func NewGraph[T comparable, W Weight]() *Graph[T, W] {
+	return &Graph[T, W]{directed: true, edges: map[T][]Edge[T, W]{}}
+}
+
+// NewUndirectedGraph creates a graph whose AddEdge connects both nodes.
+func NewUndirectedGraph[T comparable, W Weight]() *Graph[T, W] {
+	return &Graph[T, W]{edges: map[T][]Edge[T, W]{}}
+}
+
+func (g *Graph[T, W]) Directed() bool {
+	return g.directed
+}
+
+func (g *Graph[T, W]) AddNode(node T) {
+	if _, ok := g.edges[node]; !ok {
+		g.nodes = append(g.nodes, node)
+		g.edges[node] = nil
+	}
+}
+
+func (g *Graph[T, W]) AddEdge(from, to T, weight W) {
+	g.AddNode(from)
+	g.AddNode(to)
+	g.edges[from] = append(g.edges[from], Edge[T, W]{From: from, To: to, Weight: weight})
+	if !g.directed && from != to {
+		g.edges[to] = append(g.edges[to], Edge[T, W]{From: to, To: from, Weight: weight})
+	}
+}
+
+func (g *Graph[T, W]) HasNode(node T) bool {
+	_, ok := g.edges[node]
+	return ok
+}
+
+func (g *Graph[T, W]) Nodes() []T {
+	if len(g.nodes) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(g.nodes))
+	copy(result, g.nodes)
+	return result
+}
+
+func (g *Graph[T, W]) Edges(node T) []Edge[T, W] {
+	if len(g.edges[node]) == 0 {
+		return nil
+	}
+
+	result := make([]Edge[T, W], len(g.edges[node]))
+	copy(result, g.edges[node])
+	return result
+}
+
+// Neighbors returns the nodes node has an edge to. It can be used as a
+// ChildrenFunc.
+func (g *Graph[T, W]) Neighbors(node T) []T {
+	var result []T
+	for _, e := range g.edges[node] {
+		result = append(result, e.To)
+	}
+	return result
+}
+
+// TopologicalSort orders the nodes of a directed graph so that every edge goes
+// from an earlier to a later node. Undirected graphs return ErrUndirected.
+func (g *Graph[T, W]) TopologicalSort() (iterator.IIterator[T], error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+	return NewTopologicalIter(g.nodes, g.Neighbors)
+}
+
+// ShortestPath returns the path from from to to with the fewest edges.
+func (g *Graph[T, W]) ShortestPath(from, to T) (iterator.IIterator[T], error) {
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return nil, ErrNodeNotFound
+	}
+
+	parents := map[T]T{from: from}
+	queue := []T{from}
+	for len(queue) > 0 && !hasKey(parents, to) {
+		node := queue[0]
+		queue = queue[1:]
+		for _, e := range g.edges[node] {
+			if !hasKey(parents, e.To) {
+				parents[e.To] = node
+				queue = append(queue, e.To)
+			}
+		}
+	}
+
+	if !hasKey(parents, to) {
+		return nil, ErrNoPath
+	}
+	return iterator.NewIterator(pathTo(parents, from, to)), nil
+}
+
+// ShortestWeightedPath returns the path from from to to with the lowest total
+// weight using Dijkstra's algorithm, along with that weight. It fails with
+// ErrNegativeWeight when it reaches an edge with a negative weight.
+func (g *Graph[T, W]) ShortestWeightedPath(from, to T) (iterator.IIterator[T], W, error) {
+	var zero W
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return nil, zero, ErrNodeNotFound
+	}
+
+	parents := map[T]T{from: from}
+	dist := map[T]W{from: 0}
+	settled := map[T]struct{}{}
+	queue := &distanceQueue[T, W]{{node: from}}
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(distanceItem[T, W])
+		if hasKey(settled, current.node) {
+			continue
+		}
+		settled[current.node] = struct{}{}
+		if current.node == to {
+			break
+		}
+
+		for _, e := range g.edges[current.node] {
+			if e.Weight < 0 {
+				return nil, zero, ErrNegativeWeight
+			}
+			candidate := current.dist + e.Weight
+			if d, ok := dist[e.To]; !ok || candidate < d {
+				dist[e.To] = candidate
+				parents[e.To] = current.node
+				heap.Push(queue, distanceItem[T, W]{node: e.To, dist: candidate})
+			}
+		}
+	}
+
+	if !hasKey(settled, to) {
+		return nil, zero, ErrNoPath
+	}
+	return iterator.NewIterator(pathTo(parents, from, to)), dist[to], nil
+}
+
+// ConnectedComponents yields the nodes of each component, ignoring the edge
+// direction of directed graphs.
+func (g *Graph[T, W]) ConnectedComponents() iterator.IIterator[[]T] {
+	return NewComponentsIter(g.nodes, g.Neighbors)
+}
+
+func pathTo[T comparable](parents map[T]T, from, to T) []T {
+	path := []T{to}
+	for node := to; node != from; {
+		node = parents[node]
+		path = append(path, node)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+func hasKey[K comparable, V any](m map[K]V, key K) bool {
+	_, ok := m[key]
+	return ok
+}
+
+type distanceItem[T comparable, W Weight] struct {
+	node T
+	dist W
+}
+
+type distanceQueue[T comparable, W Weight] []distanceItem[T, W]
+
+func (q distanceQueue[T, W]) Len() int           { return len(q) }
+func (q distanceQueue[T, W]) Less(i, j int) bool { return q[i].dist < q[j].dist }
+func (q distanceQueue[T, W]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *distanceQueue[T, W]) Push(x any)        { *q = append(*q, x.(distanceItem[T, W])) }
+func (q *distanceQueue[T, W]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	newCityGraph := func() *Graph[string, float64] {
+		g := NewUndirectedGraph[string, float64]()
+		g.AddEdge("a", "b", 7)
+		g.AddEdge("a", "c", 9)
+		g.AddEdge("a", "f", 14)
+		g.AddEdge("b", "c", 10)
+		g.AddEdge("b", "d", 15)
+		g.AddEdge("c", "d", 11)
+		g.AddEdge("c", "f", 2)
+		g.AddEdge("d", "e", 6)
+		g.AddEdge("e", "f", 9)
+		g.AddNode("z")
+		return g
+	}
+
+	t.Run("structure", func(tt *testing.T) {
+		g := newCityGraph()
+
+		assert.False(tt, g.Directed())
+		assert.Equal(tt, []string{"a", "b", "c", "f", "d", "e", "z"}, g.Nodes())
+		assert.Equal(tt, []string{"a", "c", "d"}, g.Neighbors("b"))
+		assert.Equal(tt, []Edge[string, float64]{{From: "e", To: "d", Weight: 6}, {From: "e", To: "f", Weight: 9}}, g.Edges("e"))
+		assert.Nil(tt, g.Edges("z"))
+		assert.True(tt, g.HasNode("z"))
+		assert.False(tt, g.HasNode("y"))
+	})
+
+	t.Run("shortest path", func(tt *testing.T) {
+		g := newCityGraph()
+
+		path, err := g.ShortestPath("a", "e")
+		assert.NoError(tt, err)
+		assert.Equal(tt, []string{"a", "f", "e"}, iterator.Collect[string](path))
+
+		path, err = g.ShortestPath("a", "a")
+		assert.NoError(tt, err)
+		assert.Equal(tt, []string{"a"}, iterator.Collect[string](path))
+
+		_, err = g.ShortestPath("a", "z")
+		assert.ErrorIs(tt, err, ErrNoPath)
+		_, err = g.ShortestPath("a", "y")
+		assert.ErrorIs(tt, err, ErrNodeNotFound)
+	})
+
+	t.Run("shortest weighted path", func(tt *testing.T) {
+		g := newCityGraph()
+
+		path, weight, err := g.ShortestWeightedPath("a", "e")
+		assert.NoError(tt, err)
+		assert.Equal(tt, []string{"a", "c", "f", "e"}, iterator.Collect[string](path))
+		assert.Equal(tt, 20.0, weight)
+
+		_, _, err = g.ShortestWeightedPath("a", "z")
+		assert.ErrorIs(tt, err, ErrNoPath)
+		_, _, err = g.ShortestWeightedPath("y", "a")
+		assert.ErrorIs(tt, err, ErrNodeNotFound)
+
+		g.AddEdge("a", "g", -1)
+		_, _, err = g.ShortestWeightedPath("a", "e")
+		assert.ErrorIs(tt, err, ErrNegativeWeight)
+	})
+
+	t.Run("directed", func(tt *testing.T) {
+		g := NewGraph[int, int]()
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(2, 3, 1)
+		g.AddEdge(1, 3, 5)
+		g.AddEdge(4, 3, 1)
+
+		_, err := g.ShortestPath(3, 1)
+		assert.ErrorIs(tt, err, ErrNoPath)
+
+		path, weight, err := g.ShortestWeightedPath(1, 3)
+		assert.NoError(tt, err)
+		assert.Equal(tt, []int{1, 2, 3}, iterator.Collect[int](path))
+		assert.Equal(tt, 2, weight)
+
+		order, err := g.TopologicalSort()
+		assert.NoError(tt, err)
+		assert.Equal(tt, []int{4, 1, 2, 3}, iterator.Collect[int](order))
+
+		g.AddEdge(3, 1, 1)
+		_, err = g.TopologicalSort()
+		assert.EqualError(tt, err, "graph: cycle detected: 1 -> 2 -> 3 -> 1")
+
+		undirected := NewUndirectedGraph[string, int]()
+		undirected.AddEdge("a", "b", 1)
+		_, err = undirected.TopologicalSort()
+		assert.ErrorIs(tt, err, ErrUndirected)
+	})
+
+	t.Run("connected components", func(tt *testing.T) {
+		g := NewGraph[int, int]()
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(3, 2, 1)
+		g.AddEdge(4, 5, 1)
+		g.AddNode(6)
+
+		assert.Equal(tt, [][]int{{1, 2, 3}, {4, 5}, {6}}, iterator.Collect[[]int](g.ConnectedComponents()))
+		assert.Equal(tt, [][]int{{3, 2}, {6}}, iterator.Collect[[]int](NewComponentsIter([]int{3, 6}, g.Neighbors)))
+	})
+}
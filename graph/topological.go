@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/oculius/optio/iterator"
+)
+
+// CycleError reports a cycle as the path of nodes leading back to its first
+// node, which is repeated at the end.
+type CycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *CycleError[T]) Error() string {
+	nodes := make([]string, len(e.Cycle))
+	for i := range e.Cycle {
+		nodes[i] = fmt.Sprint(e.Cycle[i])
+	}
+	return "graph: cycle detected: " + strings.Join(nodes, " -> ")
+}
+
+// NewTopologicalIter yields every node reachable from roots before all of its
+// children. It fails with a *CycleError when the nodes contain a cycle.
+func NewTopologicalIter[T comparable](roots []T, children ChildrenFunc[T]) (iterator.IIterator[T], error) {
+	order, err := topologicalSort(roots, children)
+	if err != nil {
+		return nil, err
+	}
+	return iterator.NewIterator(order), nil
+}
+
+// FindCycle returns a *CycleError describing a cycle reachable from roots, or
+// nil when there is none.
+func FindCycle[T comparable](roots []T, children ChildrenFunc[T]) error {
+	_, err := topologicalSort(roots, children)
+	return err
+}
+
+func topologicalSort[T comparable](roots []T, children ChildrenFunc[T]) ([]T, error) {
+	const (
+		visiting = iota + 1
+		done
+	)
+
+	var order []T
+	state := map[T]int{}
+	for _, root := range roots {
+		if state[root] != 0 {
+			continue
+		}
+
+		state[root] = visiting
+		stack := []dfsFrame[T]{{node: root, children: children(root)}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.next == len(top.children) {
+				state[top.node] = done
+				order = append(order, top.node)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			child := top.children[top.next]
+			top.next++
+			switch state[child] {
+			case 0:
+				state[child] = visiting
+				stack = append(stack, dfsFrame[T]{node: child, children: children(child)})
+			case visiting:
+				return nil, &CycleError[T]{Cycle: cycleOf(stack, child)}
+			}
+		}
+	}
+
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return order, nil
+}
+
+func cycleOf[T comparable](stack []dfsFrame[T], node T) []T {
+	start := len(stack) - 1
+	for stack[start].node != node {
+		start--
+	}
+
+	cycle := make([]T, 0, len(stack)-start+1)
+	for _, frame := range stack[start:] {
+		cycle = append(cycle, frame.node)
+	}
+	return append(cycle, node)
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTopologicalIter(t *testing.T) {
+	t.Run("order", func(tt *testing.T) {
+		deps := map[string][]string{
+			"app":    {"http", "db"},
+			"http":   {"log"},
+			"db":     {"log", "config"},
+			"log":    {"config"},
+			"config": nil,
+		}
+		iter, err := NewTopologicalIter([]string{"app"}, func(s string) []string { return deps[s] })
+
+		assert.NoError(tt, err)
+		order := iterator.Collect[string](iter)
+		assert.Equal(tt, []string{"app", "db", "http", "log", "config"}, order)
+
+		position := map[string]int{}
+		for i, node := range order {
+			position[node] = i
+		}
+		for node, children := range deps {
+			for _, child := range children {
+				assert.Less(tt, position[node], position[child], "%s -> %s", node, child)
+			}
+		}
+	})
+
+	t.Run("cycle", func(tt *testing.T) {
+		_, err := NewTopologicalIter([]string{"b", "c"}, testChildren)
+
+		var cycleErr *CycleError[string]
+		assert.ErrorAs(tt, err, &cycleErr)
+		assert.Equal(tt, []string{"c", "f", "a", "c"}, cycleErr.Cycle)
+		assert.EqualError(tt, err, "graph: cycle detected: c -> f -> a -> c")
+	})
+
+	t.Run("find cycle", func(tt *testing.T) {
+		assert.Error(tt, FindCycle([]string{"a"}, testChildren))
+		assert.NoError(tt, FindCycle([]string{"b"}, func(s string) []string { return testTree[s][:0] }))
+		assert.EqualError(tt, FindCycle([]int{1}, func(n int) []int { return []int{n} }), "graph: cycle detected: 1 -> 1")
+	})
+}
@@ -0,0 +1,196 @@
+package graph
+
+import (
+	"github.com/oculius/optio/collection"
+	"github.com/oculius/optio/iterator"
+)
+
+// ChildrenFunc returns the nodes directly reachable from a node. Traversals visit
+// each node once, so it may describe graphs with shared nodes and cycles.
+type ChildrenFunc[T comparable] func(T) []T
+
+type dfsPreOrderIterator[T comparable] struct {
+	roots    []T
+	children ChildrenFunc[T]
+	stack    []T
+	visited  map[T]struct{}
+	value    T
+}
+
+// NewDFSPreOrderIter yields every node reachable from roots before its
+// children, visiting children in order.
+func NewDFSPreOrderIter[T comparable](roots []T, children ChildrenFunc[T]) iterator.IIterator[T] {
+	it := &dfsPreOrderIterator[T]{roots: roots, children: children}
+	it.Reset()
+	return it
+}
+
+func (it *dfsPreOrderIterator[T]) Next() bool {
+	for len(it.stack) > 0 {
+		node := it.stack[len(it.stack)-1]
+		it.stack = it.stack[:len(it.stack)-1]
+		if _, ok := it.visited[node]; ok {
+			continue
+		}
+
+		it.visited[node] = struct{}{}
+		children := it.children(node)
+		for i := len(children) - 1; i >= 0; i-- {
+			if _, ok := it.visited[children[i]]; !ok {
+				it.stack = append(it.stack, children[i])
+			}
+		}
+		it.value = node
+		return true
+	}
+	return false
+}
+
+func (it *dfsPreOrderIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *dfsPreOrderIterator[T]) Reset() {
+	*it = dfsPreOrderIterator[T]{roots: it.roots, children: it.children, visited: map[T]struct{}{}}
+	for i := len(it.roots) - 1; i >= 0; i-- {
+		it.stack = append(it.stack, it.roots[i])
+	}
+}
+
+func (it *dfsPreOrderIterator[T]) Collect() []T {
+	return drain[T](NewDFSPreOrderIter(it.roots, it.children))
+}
+
+type dfsFrame[T comparable] struct {
+	node     T
+	children []T
+	next     int
+}
+
+type dfsPostOrderIterator[T comparable] struct {
+	roots    []T
+	children ChildrenFunc[T]
+	root     int
+	stack    []dfsFrame[T]
+	visited  map[T]struct{}
+	value    T
+}
+
+// NewDFSPostOrderIter yields every node reachable from roots after its children,
+// visiting children in order.
+func NewDFSPostOrderIter[T comparable](roots []T, children ChildrenFunc[T]) iterator.IIterator[T] {
+	return &dfsPostOrderIterator[T]{roots: roots, children: children, visited: map[T]struct{}{}}
+}
+
+func (it *dfsPostOrderIterator[T]) Next() bool {
+	for {
+		if len(it.stack) == 0 {
+			for it.root < len(it.roots) && it.isVisited(it.roots[it.root]) {
+				it.root++
+			}
+			if it.root == len(it.roots) {
+				return false
+			}
+			it.push(it.roots[it.root])
+		}
+
+		top := &it.stack[len(it.stack)-1]
+		if top.next < len(top.children) {
+			child := top.children[top.next]
+			top.next++
+			if !it.isVisited(child) {
+				it.push(child)
+			}
+			continue
+		}
+
+		it.value = top.node
+		it.stack = it.stack[:len(it.stack)-1]
+		return true
+	}
+}
+
+func (it *dfsPostOrderIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *dfsPostOrderIterator[T]) Reset() {
+	*it = dfsPostOrderIterator[T]{roots: it.roots, children: it.children, visited: map[T]struct{}{}}
+}
+
+func (it *dfsPostOrderIterator[T]) Collect() []T {
+	return drain[T](NewDFSPostOrderIter(it.roots, it.children))
+}
+
+func (it *dfsPostOrderIterator[T]) isVisited(node T) bool {
+	_, ok := it.visited[node]
+	return ok
+}
+
+func (it *dfsPostOrderIterator[T]) push(node T) {
+	it.visited[node] = struct{}{}
+	it.stack = append(it.stack, dfsFrame[T]{node: node, children: it.children(node)})
+}
+
+type bfsIterator[T comparable] struct {
+	roots    []T
+	children ChildrenFunc[T]
+	queue    *collection.Deque[T]
+	visited  map[T]struct{}
+	value    T
+}
+
+// NewBFSIter yields every node reachable from roots level by level.
+func NewBFSIter[T comparable](roots []T, children ChildrenFunc[T]) iterator.IIterator[T] {
+	it := &bfsIterator[T]{roots: roots, children: children}
+	it.Reset()
+	return it
+}
+
+func (it *bfsIterator[T]) Next() bool {
+	node, ok := it.queue.PopFront()
+	if !ok {
+		return false
+	}
+
+	for _, child := range it.children(node) {
+		it.enqueue(child)
+	}
+	it.value = node
+	return true
+}
+
+func (it *bfsIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *bfsIterator[T]) Reset() {
+	*it = bfsIterator[T]{
+		roots:    it.roots,
+		children: it.children,
+		queue:    collection.NewDeque[T](),
+		visited:  map[T]struct{}{},
+	}
+	for _, root := range it.roots {
+		it.enqueue(root)
+	}
+}
+
+func (it *bfsIterator[T]) Collect() []T {
+	return drain[T](NewBFSIter(it.roots, it.children))
+}
+
+func (it *bfsIterator[T]) enqueue(node T) {
+	if _, ok := it.visited[node]; !ok {
+		it.visited[node] = struct{}{}
+		it.queue.PushBack(node)
+	}
+}
+
+func drain[T any](iter iterator.Iterator[T]) []T {
+	var result []T
+	for iter.Next() {
+		result = append(result, iter.Value())
+	}
+	return result
+}
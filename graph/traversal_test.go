@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// testTree has the edges a -> b, c; b -> d, e; c -> e, f and f -> a.
+var testTree = map[string][]string{
+	"a": {"b", "c"},
+	"b": {"d", "e"},
+	"c": {"e", "f"},
+	"f": {"a"},
+}
+
+func testChildren(node string) []string {
+	return testTree[node]
+}
+
+func TestDFS(t *testing.T) {
+	t.Run("pre order", func(tt *testing.T) {
+		iter := NewDFSPreOrderIter([]string{"a"}, testChildren)
+
+		assert.Equal(tt, []string{"a", "b", "d", "e", "c", "f"}, drain[string](iter))
+		assert.Equal(tt, []string{"a", "b", "d", "e", "c", "f"}, iter.Collect())
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, "a", iter.Value())
+	})
+
+	t.Run("post order", func(tt *testing.T) {
+		iter := NewDFSPostOrderIter([]string{"c", "a", "x"}, testChildren)
+
+		assert.Equal(tt, []string{"e", "d", "b", "a", "f", "c", "x"}, drain[string](iter))
+		assert.Equal(tt, []string{"e", "d", "b", "a", "f", "c", "x"}, iter.Collect())
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, "e", iter.Value())
+	})
+
+	t.Run("composes with filter", func(tt *testing.T) {
+		iter := iterator.NewFilterIter(NewDFSPreOrderIter([]string{"b", "c"}, testChildren), func(s string) bool {
+			return s != "a"
+		})
+
+		assert.Equal(tt, []string{"b", "d", "e", "c", "f"}, drain[string](iter))
+	})
+
+	t.Run("empty", func(tt *testing.T) {
+		assert.Nil(tt, NewDFSPreOrderIter(nil, testChildren).Collect())
+		assert.Nil(tt, NewDFSPostOrderIter(nil, testChildren).Collect())
+	})
+}
+
+func TestBFS(t *testing.T) {
+	iter := NewBFSIter([]string{"a", "e"}, testChildren)
+
+	assert.Equal(t, []string{"a", "e", "b", "c", "d", "f"}, drain[string](iter))
+	assert.Equal(t, []string{"a", "e", "b", "c", "d", "f"}, iter.Collect())
+	iter.Reset()
+	assert.True(t, iter.Next())
+	assert.Equal(t, "a", iter.Value())
+	assert.Nil(t, NewBFSIter(nil, testChildren).Collect())
+}
@@ -0,0 +1,78 @@
+package iterator
+
+import "context"
+
+// chanIterator reads from a channel, which cannot be replayed, so it is not
+// Resettable. Collect drains the values that have not been read by Next yet.
+type chanIterator[T any] struct {
+	ctx    context.Context
+	source <-chan T
+	value  T
+	err    error
+	done   bool
+}
+
+func NewChanIterator[T any](ch <-chan T) ErrorIterator[T] {
+	return NewChanIteratorContext(context.Background(), ch)
+}
+
+func NewChanIteratorContext[T any](ctx context.Context, ch <-chan T) ErrorIterator[T] {
+	return &chanIterator[T]{ctx: ctx, source: ch}
+}
+
+func (it *chanIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	select {
+	case <-it.ctx.Done():
+		it.err = it.ctx.Err()
+	case value, ok := <-it.source:
+		if ok {
+			it.value = value
+			return true
+		}
+	}
+	it.done = true
+	return false
+}
+
+func (it *chanIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *chanIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *chanIterator[T]) Collect() []T {
+	var result []T
+	for it.Next() {
+		result = append(result, it.value)
+	}
+	return result
+}
+
+func ToChannel[T any](iter IIterator[T], buffer int) <-chan T {
+	return ToChannelContext(context.Background(), iter, buffer)
+}
+
+// ToChannelContext sends the values of iter to the returned channel from a new
+// goroutine. The goroutine stops and closes the channel once iter is exhausted
+// or ctx is done, so cancelling ctx releases it even if the channel is no
+// longer read.
+func ToChannelContext[T any](ctx context.Context, iter IIterator[T], buffer int) <-chan T {
+	out := make(chan T, buffer)
+	go func() {
+		defer close(out)
+		for iter.Next() {
+			select {
+			case out <- iter.Value():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,75 @@
+package iterator
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChanIterator(t *testing.T) {
+	t.Run("reads until closed", func(tt *testing.T) {
+		ch := make(chan int, 4)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		ch <- 4
+		close(ch)
+		iter := NewChanIterator(ch)
+
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 1, iter.Value())
+		assert.False(tt, Reset[int](iter))
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 2, iter.Value())
+		assert.Equal(tt, []int{3, 4}, Collect[int](iter))
+		assert.False(tt, iter.Next())
+		assert.Nil(tt, Collect[int](iter))
+		assert.Nil(tt, iter.Err())
+	})
+
+	t.Run("composes with filter and map", func(tt *testing.T) {
+		ch := make(chan int)
+		go func() {
+			defer close(ch)
+			for i := 1; i <= 6; i++ {
+				ch <- i
+			}
+		}()
+
+		iter := NewMapIter(NewFilterIter(FromIterator[int](NewChanIterator(ch)), func(x int) bool { return x%2 == 0 }),
+			func(x int) int { return x * x })
+		assert.Equal(tt, []int{4, 16, 36}, iter.Collect())
+	})
+
+	t.Run("stops on cancel", func(tt *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := make(chan int)
+		iter := NewChanIteratorContext(ctx, ch)
+		cancel()
+
+		assert.False(tt, iter.Next())
+		assert.ErrorIs(tt, iter.Err(), context.Canceled)
+	})
+}
+
+func TestToChannel(t *testing.T) {
+	t.Run("sends all values", func(tt *testing.T) {
+		ch := ToChannel(NewIterator([]int{1, 2, 3}), 1)
+
+		var result []int
+		for v := range ch {
+			result = append(result, v)
+		}
+		assert.Equal(tt, []int{1, 2, 3}, result)
+	})
+
+	t.Run("stops on cancel", func(tt *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := ToChannelContext(ctx, NewIterator([]int{1, 2, 3}), 0)
+
+		assert.Equal(tt, 1, <-ch)
+		cancel()
+		for range ch {
+		}
+	})
+}
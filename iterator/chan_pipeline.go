@@ -0,0 +1,158 @@
+package iterator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Every stage runs in its own goroutine and closes its output once its input is
+// closed or ctx is done. Cancelling ctx is enough to release all goroutines of a
+// pipeline, even when the final output is no longer read.
+
+func send[T any](ctx context.Context, out chan<- T, value T) bool {
+	select {
+	case out <- value:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func receive[T any](ctx context.Context, in <-chan T) (value T, ok bool) {
+	select {
+	case value, ok = <-in:
+		return value, ok
+	case <-ctx.Done():
+		return value, false
+	}
+}
+
+func MapChan[T any, K any](ctx context.Context, in <-chan T, f MapFunction[T, K]) <-chan K {
+	out := make(chan K)
+	go func() {
+		defer close(out)
+		for {
+			value, ok := receive(ctx, in)
+			if !ok || !send(ctx, out, f(value)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func FilterChan[T any](ctx context.Context, in <-chan T, f FilterFunction[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			value, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			if f(value) && !send(ctx, out, value) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FanOut distributes the values of in over n output channels, each value being
+// delivered to exactly one of them.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	result := make([]<-chan T, n)
+	for i := 0; i < n; i++ {
+		out := make(chan T)
+		result[i] = out
+		go func() {
+			defer close(out)
+			for {
+				value, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, value) {
+					return
+				}
+			}
+		}()
+	}
+	return result
+}
+
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan T) {
+			defer wg.Done()
+			for {
+				value, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, value) {
+					return
+				}
+			}
+		}(in)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// BatchChan groups the values of in into batches of at most size values. When
+// interval is positive, a batch is also emitted once interval has elapsed since
+// its first value was received. A partial batch is emitted when in is closed and
+// dropped when ctx is done.
+func BatchChan[T any](ctx context.Context, in <-chan T, size int, interval time.Duration) <-chan []T {
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+
+		var batch []T
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			result := batch
+			batch = nil
+			return send(ctx, out, result)
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			case value, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				if len(batch) == 0 && interval > 0 {
+					timer = time.NewTimer(interval)
+					timeout = timer.C
+				}
+				batch = append(batch, value)
+				if len(batch) >= size && !flush() {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,69 @@
+package iterator
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+	"time"
+)
+
+func drain[T any](ch <-chan T) []T {
+	var result []T
+	for v := range ch {
+		result = append(result, v)
+	}
+	return result
+}
+
+func TestChanPipeline(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("map and filter", func(tt *testing.T) {
+		in := ToChannel(NewIterator([]int{1, 2, 3, 4, 5}), 0)
+		out := MapChan(ctx, FilterChan(ctx, in, func(x int) bool { return x%2 == 1 }),
+			func(x int) string { return string(rune('a' + x)) })
+
+		assert.Equal(tt, []string{"b", "d", "f"}, drain(out))
+	})
+
+	t.Run("fan out and fan in", func(tt *testing.T) {
+		in := ToChannel(NewIterator([]int{1, 2, 3, 4, 5, 6, 7, 8}), 0)
+		outs := FanOut(ctx, in, 3)
+		assert.Len(tt, outs, 3)
+
+		result := drain(FanIn(ctx, outs...))
+		sort.Ints(result)
+		assert.Equal(tt, []int{1, 2, 3, 4, 5, 6, 7, 8}, result)
+	})
+
+	t.Run("batch by size", func(tt *testing.T) {
+		in := ToChannel(NewIterator([]int{1, 2, 3, 4, 5}), 0)
+
+		assert.Equal(tt, [][]int{{1, 2}, {3, 4}, {5}}, drain(BatchChan(ctx, in, 2, 0)))
+	})
+
+	t.Run("batch by time", func(tt *testing.T) {
+		in := make(chan int)
+		out := BatchChan(ctx, in, 10, 10*time.Millisecond)
+
+		in <- 1
+		in <- 2
+		assert.Equal(tt, []int{1, 2}, <-out)
+		in <- 3
+		close(in)
+		assert.Equal(tt, [][]int{{3}}, drain(out))
+	})
+
+	t.Run("cancel releases stages", func(tt *testing.T) {
+		cctx, cancel := context.WithCancel(ctx)
+		in := make(chan int)
+		out := FanIn(cctx, MapChan(cctx, FilterChan(cctx, in, func(int) bool { return true }),
+			func(x int) int { return x }))
+		batches := BatchChan(cctx, out, 5, time.Hour)
+
+		in <- 1
+		cancel()
+		assert.Nil(tt, drain(batches))
+	})
+}
@@ -0,0 +1,245 @@
+package iterator
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/oculius/optio/fn"
+	"golang.org/x/exp/constraints"
+)
+
+// Collector describes a terminal operation: Supplier creates an empty container,
+// Accumulator adds an element to it, Combiner merges two partial containers and
+// Finisher turns the container into the result. Accumulator and Combiner may
+// modify and return their first argument.
+type Collector[T any, A any, R any] struct {
+	Supplier    fn.SilentSupplier[A]
+	Accumulator ReducerFunction[T, A]
+	Combiner    func(A, A) A
+	Finisher    MapFunction[A, R]
+}
+
+// CollectWith feeds the values iter has not produced yet to c. Type inference
+// before go1.21 cannot match an IIterator argument to Iterator[T], so such calls
+// name the element type: CollectWith[int](NewIterator(arr), c).
+func CollectWith[T any, A any, R any](iter Iterator[T], c Collector[T, A, R]) R {
+	container := c.Supplier()
+	for iter.Next() {
+		container = c.Accumulator(container, iter.Value())
+	}
+	return c.Finisher(container)
+}
+
+// ParallelCollect splits arr into parallelism parts, accumulates them on
+// separate goroutines and merges the partial containers in order with the
+// Combiner of c.
+func ParallelCollect[T any, A any, R any](arr []T, parallelism int, c Collector[T, A, R]) R {
+	N := len(arr)
+	if parallelism > N {
+		parallelism = N
+	}
+	if parallelism <= 1 {
+		return CollectWith[T](NewIterator(arr), c)
+	}
+
+	partials := make([]A, parallelism)
+	var wg sync.WaitGroup
+	for p := 0; p < parallelism; p++ {
+		from, to := p*N/parallelism, (p+1)*N/parallelism
+		wg.Add(1)
+		go func(p int, part []T) {
+			defer wg.Done()
+			container := c.Supplier()
+			for i := range part {
+				container = c.Accumulator(container, part[i])
+			}
+			partials[p] = container
+		}(p, arr[from:to])
+	}
+	wg.Wait()
+
+	result := partials[0]
+	for p := 1; p < parallelism; p++ {
+		result = c.Combiner(result, partials[p])
+	}
+	return c.Finisher(result)
+}
+
+func identity[T any](v T) T {
+	return v
+}
+
+func ToSlice[T any]() Collector[T, []T, []T] {
+	return Collector[T, []T, []T]{
+		Supplier:    func() []T { return nil },
+		Accumulator: func(a []T, v T) []T { return append(a, v) },
+		Combiner:    func(a, b []T) []T { return append(a, b...) },
+		Finisher:    identity[[]T],
+	}
+}
+
+func ToSet[T comparable]() Collector[T, map[T]struct{}, map[T]struct{}] {
+	return Collector[T, map[T]struct{}, map[T]struct{}]{
+		Supplier: func() map[T]struct{} { return map[T]struct{}{} },
+		Accumulator: func(a map[T]struct{}, v T) map[T]struct{} {
+			a[v] = struct{}{}
+			return a
+		},
+		Combiner: func(a, b map[T]struct{}) map[T]struct{} {
+			for k := range b {
+				a[k] = struct{}{}
+			}
+			return a
+		},
+		Finisher: identity[map[T]struct{}],
+	}
+}
+
+// ToMap collects values into a map. When two values share a key, merge receives
+// the existing and the new value, and the new value replaces the existing one
+// when merge is nil.
+func ToMap[T any, K comparable, V any](keyFn MapFunction[T, K], valFn MapFunction[T, V], merge func(V, V) V) Collector[T, map[K]V, map[K]V] {
+	put := func(a map[K]V, k K, v V) {
+		if old, ok := a[k]; ok && merge != nil {
+			v = merge(old, v)
+		}
+		a[k] = v
+	}
+
+	return Collector[T, map[K]V, map[K]V]{
+		Supplier: func() map[K]V { return map[K]V{} },
+		Accumulator: func(a map[K]V, v T) map[K]V {
+			put(a, keyFn(v), valFn(v))
+			return a
+		},
+		Combiner: func(a, b map[K]V) map[K]V {
+			for k, v := range b {
+				put(a, k, v)
+			}
+			return a
+		},
+		Finisher: identity[map[K]V],
+	}
+}
+
+func GroupingBy[T any, K comparable, A any, R any](keyFn MapFunction[T, K], downstream Collector[T, A, R]) Collector[T, map[K]A, map[K]R] {
+	return Collector[T, map[K]A, map[K]R]{
+		Supplier: func() map[K]A { return map[K]A{} },
+		Accumulator: func(a map[K]A, v T) map[K]A {
+			k := keyFn(v)
+			container, ok := a[k]
+			if !ok {
+				container = downstream.Supplier()
+			}
+			a[k] = downstream.Accumulator(container, v)
+			return a
+		},
+		Combiner: func(a, b map[K]A) map[K]A {
+			for k, container := range b {
+				if existing, ok := a[k]; ok {
+					container = downstream.Combiner(existing, container)
+				}
+				a[k] = container
+			}
+			return a
+		},
+		Finisher: func(a map[K]A) map[K]R {
+			result := make(map[K]R, len(a))
+			for k, container := range a {
+				result[k] = downstream.Finisher(container)
+			}
+			return result
+		},
+	}
+}
+
+func PartitioningBy[T any, A any, R any](pred fn.SilentPredicate[T], downstream Collector[T, A, R]) Collector[T, map[bool]A, map[bool]R] {
+	grouping := GroupingBy(MapFunction[T, bool](pred), downstream)
+	finisher := grouping.Finisher
+	grouping.Finisher = func(a map[bool]A) map[bool]R {
+		for _, k := range []bool{false, true} {
+			if _, ok := a[k]; !ok {
+				a[k] = downstream.Supplier()
+			}
+		}
+		return finisher(a)
+	}
+	return grouping
+}
+
+func Joining(sep string) Collector[string, []string, string] {
+	c := ToSlice[string]()
+	return Collector[string, []string, string]{
+		Supplier:    c.Supplier,
+		Accumulator: c.Accumulator,
+		Combiner:    c.Combiner,
+		Finisher:    func(a []string) string { return strings.Join(a, sep) },
+	}
+}
+
+func Counting[T any]() Collector[T, int, int] {
+	return Collector[T, int, int]{
+		Supplier:    func() int { return 0 },
+		Accumulator: func(a int, _ T) int { return a + 1 },
+		Combiner:    func(a, b int) int { return a + b },
+		Finisher:    identity[int],
+	}
+}
+
+func Summing[T any, N constraints.Integer | constraints.Float](mapper MapFunction[T, N]) Collector[T, N, N] {
+	return Collector[T, N, N]{
+		Supplier:    func() N { return 0 },
+		Accumulator: func(a N, v T) N { return a + mapper(v) },
+		Combiner:    func(a, b N) N { return a + b },
+		Finisher:    identity[N],
+	}
+}
+
+// AverageState is the container of Averaging.
+type AverageState struct {
+	Sum   float64
+	Count int
+}
+
+// Averaging returns 0 when no value has been collected.
+func Averaging[T any, N constraints.Integer | constraints.Float](mapper MapFunction[T, N]) Collector[T, AverageState, float64] {
+	return Collector[T, AverageState, float64]{
+		Supplier: func() AverageState { return AverageState{} },
+		Accumulator: func(a AverageState, v T) AverageState {
+			return AverageState{Sum: a.Sum + float64(mapper(v)), Count: a.Count + 1}
+		},
+		Combiner: func(a, b AverageState) AverageState {
+			return AverageState{Sum: a.Sum + b.Sum, Count: a.Count + b.Count}
+		},
+		Finisher: func(a AverageState) float64 {
+			if a.Count == 0 {
+				return 0
+			}
+			return a.Sum / float64(a.Count)
+		},
+	}
+}
+
+// MinBy returns nil when no value has been collected. Ties go to the value
+// collected first.
+func MinBy[T any](cmp fn.Comparator[T]) Collector[T, *T, *T] {
+	pick := func(a *T, v *T) *T {
+		if a == nil || (v != nil && cmp(*v, *a) < 0) {
+			return v
+		}
+		return a
+	}
+
+	return Collector[T, *T, *T]{
+		Supplier:    func() *T { return nil },
+		Accumulator: func(a *T, v T) *T { return pick(a, &v) },
+		Combiner:    pick,
+		Finisher:    identity[*T],
+	}
+}
+
+// MaxBy returns nil when no value has been collected. Ties go to the value
+// collected first.
+func MaxBy[T any](cmp fn.Comparator[T]) Collector[T, *T, *T] {
+	return MinBy(cmp.Reverse())
+}
@@ -0,0 +1,127 @@
+package iterator
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type employee struct {
+	name   string
+	dept   string
+	salary int
+}
+
+type countdown struct{ n int }
+
+func (c *countdown) Next() bool {
+	c.n--
+	return c.n >= 0
+}
+
+func (c *countdown) Value() int {
+	return c.n
+}
+
+func TestCollector(t *testing.T) {
+	employees := []employee{
+		{"ann", "eng", 100},
+		{"bob", "eng", 80},
+		{"cid", "ops", 70},
+		{"dan", "sales", 90},
+		{"eve", "ops", 70},
+	}
+	name := func(e employee) string { return e.name }
+	dept := func(e employee) string { return e.dept }
+	salary := func(e employee) int { return e.salary }
+	bySalary := fn.Comparator[employee](func(a, b employee) int { return a.salary - b.salary })
+
+	t.Run("to slice", func(tt *testing.T) {
+		result := CollectWith[int](NewFilterIterFromArr([]int{1, 2, 3, 4}, func(x int) bool { return x > 1 }), ToSlice[int]())
+
+		assert.Equal(tt, []int{2, 3, 4}, result)
+	})
+
+	t.Run("plain iterator", func(tt *testing.T) {
+		assert.Equal(tt, []int{2, 1, 0}, CollectWith(Iterator[int](&countdown{3}), ToSlice[int]()))
+		assert.Equal(tt, 1.0, CollectWith(Iterator[int](&countdown{3}), Averaging(func(x int) int { return x })))
+	})
+
+	t.Run("to set", func(tt *testing.T) {
+		result := CollectWith[string](NewIterator([]string{"a", "b", "a"}), ToSet[string]())
+
+		assert.Equal(tt, map[string]struct{}{"a": {}, "b": {}}, result)
+	})
+
+	t.Run("to map", func(tt *testing.T) {
+		result := CollectWith[employee](NewIterator(employees), ToMap(dept, salary, func(a, b int) int { return a + b }))
+		assert.Equal(tt, map[string]int{"eng": 180, "ops": 140, "sales": 90}, result)
+
+		names := CollectWith[employee](NewIterator(employees), ToMap(dept, name, nil))
+		assert.Equal(tt, map[string]string{"eng": "bob", "ops": "eve", "sales": "dan"}, names)
+	})
+
+	t.Run("grouping by", func(tt *testing.T) {
+		result := CollectWith[employee](NewIterator(employees), GroupingBy(dept, Counting[employee]()))
+		assert.Equal(tt, map[string]int{"eng": 2, "ops": 2, "sales": 1}, result)
+
+		nested := CollectWith[employee](NewIterator(employees), GroupingBy(dept, GroupingBy(salary, Counting[employee]())))
+		assert.Equal(tt, map[string]map[int]int{"eng": {100: 1, 80: 1}, "ops": {70: 2}, "sales": {90: 1}}, nested)
+	})
+
+	t.Run("partitioning by", func(tt *testing.T) {
+		result := CollectWith[employee](NewIterator(employees),
+			PartitioningBy(func(e employee) bool { return e.salary >= 90 }, Summing(salary)))
+		assert.Equal(tt, map[bool]int{true: 190, false: 220}, result)
+
+		empty := CollectWith[employee](NewIterator([]employee{}), PartitioningBy(func(e employee) bool { return true }, Counting[employee]()))
+		assert.Equal(tt, map[bool]int{true: 0, false: 0}, empty)
+	})
+
+	t.Run("joining", func(tt *testing.T) {
+		result := CollectWith[string](NewMapIterFromArr(employees, name), Joining(", "))
+
+		assert.Equal(tt, "ann, bob, cid, dan, eve", result)
+	})
+
+	t.Run("averaging", func(tt *testing.T) {
+		result := CollectWith[employee](NewIterator(employees), GroupingBy(dept, Averaging(salary)))
+		assert.Equal(tt, map[string]float64{"eng": 90, "ops": 70, "sales": 90}, result)
+
+		assert.Equal(tt, 0.0, CollectWith[employee](NewIterator([]employee{}), Averaging(salary)))
+	})
+
+	t.Run("min and max", func(tt *testing.T) {
+		min := CollectWith[employee](NewIterator(employees), MinBy(bySalary))
+		max := CollectWith[employee](NewIterator(employees), MaxBy(bySalary))
+
+		assert.Equal(tt, "cid", min.name)
+		assert.Equal(tt, "ann", max.name)
+		assert.Nil(tt, CollectWith[employee](NewIterator([]employee{}), MinBy(bySalary)))
+	})
+}
+
+func TestParallelCollect(t *testing.T) {
+	arr := make([]int, 1000)
+	for i := range arr {
+		arr[i] = i
+	}
+
+	assert.Equal(t, arr, ParallelCollect(arr, 7, ToSlice[int]()))
+	assert.Equal(t, 499500, ParallelCollect(arr, 4, Summing(func(x int) int { return x })))
+	assert.Equal(t, map[bool]int{true: 500, false: 500},
+		ParallelCollect(arr, 3, PartitioningBy(func(x int) bool { return x%2 == 0 }, Counting[int]())))
+	assert.Equal(t, 999, *ParallelCollect(arr, 5, MaxBy(fn.NewNaturalComparator[int]())))
+	assert.Equal(t, 0, *ParallelCollect(arr, 5, MinBy(fn.NewNaturalComparator[int]())))
+	assert.Equal(t, 499.5, ParallelCollect(arr, 1000, Averaging(func(x int) int { return x })))
+	assert.Equal(t, "", ParallelCollect([]string{}, 4, Joining(",")))
+
+	uneven := []int{1, 2, 3, 4, 5, 6, 7}
+	for parallelism := 1; parallelism <= 8; parallelism++ {
+		assert.Equal(t, uneven, ParallelCollect(uneven, parallelism, ToSlice[int]()), parallelism)
+	}
+
+	words := strings.Fields("a b c d e f g")
+	assert.Equal(t, "a-b-c-d-e-f-g", ParallelCollect(words, 3, Joining("-")))
+}
@@ -0,0 +1,95 @@
+package iterator
+
+import "github.com/oculius/optio/sketch"
+
+type distinctIterator[T any, K comparable] struct {
+	source IIterator[T]
+	keyFn  MapFunction[T, K]
+	seen   map[K]struct{}
+}
+
+func NewDistinctIter[T comparable](iter IIterator[T]) IIterator[T] {
+	return NewDistinctByIter(iter, func(v T) T { return v })
+}
+
+func NewDistinctByIter[T any, K comparable](iter IIterator[T], keyFn MapFunction[T, K]) IIterator[T] {
+	return &distinctIterator[T, K]{source: iter, keyFn: keyFn, seen: map[K]struct{}{}}
+}
+
+func (it *distinctIterator[T, K]) Next() bool {
+	for it.source.Next() {
+		key := it.keyFn(it.source.Value())
+		if _, ok := it.seen[key]; !ok {
+			it.seen[key] = struct{}{}
+			return true
+		}
+	}
+	return false
+}
+
+func (it *distinctIterator[T, K]) Value() T {
+	return it.source.Value()
+}
+
+func (it *distinctIterator[T, K]) Reset() {
+	it.source.Reset()
+	it.seen = map[K]struct{}{}
+}
+
+func (it *distinctIterator[T, K]) Collect() []T {
+	rawResult := it.source.Collect()
+	var result []T
+	seen := map[K]struct{}{}
+	for i := range rawResult {
+		key := it.keyFn(rawResult[i])
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			result = append(result, rawResult[i])
+		}
+	}
+	return result
+}
+
+// approxDistinctIterator remembers the values seen in a Bloom filter, so its
+// memory is bounded by the filter. A false positive of the filter drops a value
+// that has not been seen before, duplicates are never let through.
+type approxDistinctIterator[T any] struct {
+	source IIterator[T]
+	filter *sketch.BloomFilter[T]
+}
+
+func NewApproxDistinctIter[T any](iter IIterator[T], filter *sketch.BloomFilter[T]) IIterator[T] {
+	return &approxDistinctIterator[T]{source: iter, filter: filter}
+}
+
+func (it *approxDistinctIterator[T]) Next() bool {
+	for it.source.Next() {
+		if !it.filter.TestAndAdd(it.source.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (it *approxDistinctIterator[T]) Value() T {
+	return it.source.Value()
+}
+
+func (it *approxDistinctIterator[T]) Reset() {
+	it.source.Reset()
+	it.filter.Clear()
+}
+
+func (it *approxDistinctIterator[T]) Collect() []T {
+	rawResult := it.source.Collect()
+	filter := it.filter.Clone()
+	filter.Clear()
+
+	var result []T
+	for i := range rawResult {
+		if !filter.TestAndAdd(rawResult[i]) {
+			result = append(result, rawResult[i])
+		}
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package iterator
+
+import (
+	"github.com/oculius/optio/sketch"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestDistinctIterator(t *testing.T) {
+	t.Run("distinct", func(tt *testing.T) {
+		iter := NewDistinctIter(NewIterator([]int{3, 1, 3, 2, 1}))
+
+		assert.Equal(tt, []int{3, 1, 2}, iter.Collect())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 3, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 1, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 2, iter.Value())
+		assert.False(tt, iter.Next())
+
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 3, iter.Value())
+	})
+
+	t.Run("distinct by", func(tt *testing.T) {
+		iter := NewDistinctByIter(NewIterator([]string{"Go", "rust", "GO", "Rust", "zig"}), strings.ToLower)
+
+		assert.Equal(tt, []string{"Go", "rust", "zig"}, iter.Collect())
+	})
+}
+
+func TestApproxDistinctIterator(t *testing.T) {
+	arr := make([]int, 0, 3000)
+	for i := 0; i < 1000; i++ {
+		arr = append(arr, i, i, 999-i)
+	}
+
+	filter := sketch.NewBloomFilter(1000, 0.001, sketch.NewIntegerHasher[int]())
+	iter := NewApproxDistinctIter(NewIterator(arr), filter)
+
+	collected := iter.Collect()
+	assert.InDelta(t, 1000, len(collected), 5)
+	assert.Equal(t, len(collected), len(NewDistinctIter(NewIterator(collected)).Collect()))
+
+	var result []int
+	for iter.Next() {
+		result = append(result, iter.Value())
+	}
+	assert.Equal(t, collected, result)
+
+	iter.Reset()
+	assert.True(t, iter.Next())
+	assert.Equal(t, 0, iter.Value())
+}
@@ -44,3 +44,7 @@ func (f *filterIterator[T]) Collect() []T {
 	}
 	return result
 }
+
+func (f *filterIterator[T]) Err() error {
+	return sourceErr(f.source)
+}
@@ -0,0 +1,281 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/oculius/optio/fn"
+	"golang.org/x/exp/constraints"
+)
+
+type generateIterator[T any] struct {
+	supplier fn.SilentSupplier[T]
+	value    T
+}
+
+func Generate[T any](supplier fn.SilentSupplier[T]) Iterator[T] {
+	return &generateIterator[T]{supplier: supplier}
+}
+
+func (it *generateIterator[T]) Next() bool {
+	it.value = it.supplier()
+	return true
+}
+
+func (it *generateIterator[T]) Value() T {
+	return it.value
+}
+
+type iterateIterator[T any] struct {
+	seed    T
+	f       MapFunction[T, T]
+	value   T
+	started bool
+}
+
+func Iterate[T any](seed T, f MapFunction[T, T]) Iterator[T] {
+	return &iterateIterator[T]{seed: seed, f: f}
+}
+
+func (it *iterateIterator[T]) Next() bool {
+	if it.started {
+		it.value = it.f(it.value)
+	} else {
+		it.value = it.seed
+		it.started = true
+	}
+	return true
+}
+
+func (it *iterateIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *iterateIterator[T]) Reset() {
+	*it = iterateIterator[T]{seed: it.seed, f: it.f}
+}
+
+type rangeIterator[T constraints.Integer | constraints.Float] struct {
+	start T
+	step  T
+	count int
+	value T
+	index int
+}
+
+// Range produces start, start+step, ... up to but excluding end. A negative step
+// counts down and a zero step produces nothing.
+func Range[T constraints.Integer | constraints.Float](start, end, step T) IIterator[T] {
+	return &rangeIterator[T]{start: start, step: step, count: rangeCount(start, end, step)}
+}
+
+// rangeCount computes the number of elements without stepping through them, in
+// uint64 for integers so that it cannot overflow T, capped at math.MaxInt.
+func rangeCount[T constraints.Integer | constraints.Float](start, end, step T) int {
+	if step == 0 || (step > 0 && start >= end) || (step < 0 && start <= end) {
+		return 0
+	}
+
+	half := 0.5
+	if T(half) != 0 {
+		count := math.Ceil((float64(end) - float64(start)) / float64(step))
+		if math.IsNaN(count) {
+			return 0
+		} else if count >= math.MaxInt {
+			return math.MaxInt
+		}
+		return int(count)
+	}
+
+	distance, stride := uint64(end)-uint64(start), uint64(step)
+	if step < 0 {
+		distance, stride = uint64(start)-uint64(end), -uint64(step)
+	}
+	count := distance / stride
+	if distance%stride != 0 {
+		count++
+	}
+	if count > math.MaxInt {
+		return math.MaxInt
+	}
+	return int(count)
+}
+
+func (it *rangeIterator[T]) Next() bool {
+	if it.index < it.count {
+		it.value = it.start + T(it.index)*it.step
+		it.index++
+		return true
+	}
+	return false
+}
+
+func (it *rangeIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *rangeIterator[T]) Reset() {
+	*it = rangeIterator[T]{start: it.start, step: it.step, count: it.count}
+}
+
+func (it *rangeIterator[T]) Collect() []T {
+	result := make([]T, it.count)
+	for i := range result {
+		result[i] = it.start + T(i)*it.step
+	}
+	return result
+}
+
+type repeatIterator[T any] struct {
+	value T
+	count int
+	index int
+}
+
+func Repeat[T any](value T, n int) IIterator[T] {
+	if n < 0 {
+		n = 0
+	}
+	return &repeatIterator[T]{value: value, count: n}
+}
+
+func (it *repeatIterator[T]) Next() bool {
+	if it.index < it.count {
+		it.index++
+		return true
+	}
+	return false
+}
+
+func (it *repeatIterator[T]) Value() T {
+	var zero T
+	if it.index == 0 {
+		return zero
+	}
+	return it.value
+}
+
+func (it *repeatIterator[T]) Reset() {
+	it.index = 0
+}
+
+func (it *repeatIterator[T]) Collect() []T {
+	result := make([]T, it.count)
+	for i := range result {
+		result[i] = it.value
+	}
+	return result
+}
+
+// cycleIterator replays source forever. Resettable sources are rewound, any
+// other source is buffered during the first pass.
+type cycleIterator[T any] struct {
+	source     Iterator[T]
+	resettable bool
+	buffer     []T
+	replaying  bool
+	index      int
+	value      T
+}
+
+func Cycle[T any](iter Iterator[T]) Iterator[T] {
+	_, resettable := iter.(Resettable)
+	return &cycleIterator[T]{source: iter, resettable: resettable}
+}
+
+func (it *cycleIterator[T]) Next() bool {
+	if it.replaying {
+		if len(it.buffer) == 0 {
+			return false
+		}
+		it.value = it.buffer[it.index]
+		it.index = (it.index + 1) % len(it.buffer)
+		return true
+	}
+
+	if it.source.Next() {
+		it.value = it.source.Value()
+		if !it.resettable {
+			it.buffer = append(it.buffer, it.value)
+		} else {
+			it.index++
+		}
+		return true
+	}
+
+	if it.resettable {
+		if it.index == 0 {
+			return false
+		}
+		it.index = 0
+		Reset(it.source)
+		return it.Next()
+	}
+
+	it.replaying = true
+	return it.Next()
+}
+
+func (it *cycleIterator[T]) Value() T {
+	return it.value
+}
+
+// limitIterator buffers the values of a source that is not Resettable, so it
+// can always be reset and collected from the start like the other iterators.
+type limitIterator[T any] struct {
+	source     Iterator[T]
+	resettable bool
+	limit      int
+	index      int
+	buffer     []T
+}
+
+func Limit[T any](iter Iterator[T], n int) IIterator[T] {
+	_, resettable := iter.(Resettable)
+	return &limitIterator[T]{source: iter, resettable: resettable, limit: n}
+}
+
+func (it *limitIterator[T]) Next() bool {
+	if it.index >= it.limit {
+		return false
+	}
+	if !it.resettable && it.index < len(it.buffer) {
+		it.index++
+		return true
+	}
+	if !it.source.Next() {
+		return false
+	}
+	if !it.resettable {
+		it.buffer = append(it.buffer, it.source.Value())
+	}
+	it.index++
+	return true
+}
+
+func (it *limitIterator[T]) Value() T {
+	if !it.resettable {
+		return it.buffer[it.index-1]
+	}
+	return it.source.Value()
+}
+
+func (it *limitIterator[T]) Reset() {
+	if it.resettable {
+		Reset(it.source)
+	}
+	it.index = 0
+}
+
+func (it *limitIterator[T]) Collect() []T {
+	index := it.index
+	it.Reset()
+	var result []T
+	for it.Next() {
+		result = append(result, it.Value())
+	}
+
+	it.Reset()
+	for it.index < index && it.Next() {
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+package iterator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	counter := 0
+	iter := Generate(func() int {
+		counter++
+		return counter
+	})
+
+	assert.Equal(t, []int{1, 2, 3}, Limit(iter, 3).Collect())
+	assert.False(t, Reset[int](iter))
+	assert.True(t, iter.Next())
+	assert.Equal(t, 4, iter.Value())
+}
+
+func TestIterate(t *testing.T) {
+	iter := Iterate(1, func(x int) int { return x * 2 })
+
+	assert.Equal(t, []int{1, 2, 4, 8, 16}, Limit(iter, 5).Collect())
+	assert.True(t, Reset[int](iter))
+	assert.True(t, iter.Next())
+	assert.Equal(t, 1, iter.Value())
+}
+
+func TestRange(t *testing.T) {
+	t.Run("ascending", func(tt *testing.T) {
+		iter := Range(0, 10, 3)
+
+		assert.Equal(tt, []int{0, 3, 6, 9}, iter.Collect())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 0, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 3, iter.Value())
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 0, iter.Value())
+	})
+
+	t.Run("descending", func(tt *testing.T) {
+		assert.Equal(tt, []int{5, 3, 1}, Range(5, 0, -2).Collect())
+	})
+
+	t.Run("float", func(tt *testing.T) {
+		assert.Equal(tt, []float64{0, 0.25, 0.5, 0.75}, Range(0, 1, 0.25).Collect())
+	})
+
+	t.Run("empty", func(tt *testing.T) {
+		assert.Equal(tt, []int{}, Range(0, 10, 0).Collect())
+		assert.Equal(tt, []int{}, Range(10, 0, 1).Collect())
+		assert.False(tt, Range(10, 0, 1).Next())
+		assert.Equal(tt, []float64{}, Range(math.NaN(), 1, 0.5).Collect())
+	})
+
+	t.Run("overflow", func(tt *testing.T) {
+		assert.Equal(tt, []int8{0, 100}, Range[int8](0, 120, 100).Collect())
+		assert.Equal(tt, []int8{-100, -50, 0, 50, 100}, Range[int8](-100, 127, 50).Collect())
+		assert.Equal(tt, []int8{127, -1}, Range[int8](127, -128, -128).Collect())
+		assert.Equal(tt, []int64{math.MinInt64, -1, math.MaxInt64 - 1}, Range[int64](math.MinInt64, math.MaxInt64, math.MaxInt64).Collect())
+	})
+
+	t.Run("unsigned", func(tt *testing.T) {
+		assert.Equal(tt, []uint8{250}, Range[uint8](250, 255, 10).Collect())
+		assert.Equal(tt, []uint8{0, 128}, Range[uint8](0, 255, 128).Collect())
+		assert.Equal(tt, []uint{3, 4}, Range[uint](3, 5, 1).Collect())
+	})
+
+	t.Run("large", func(tt *testing.T) {
+		iter := Range(0, 1<<40, 1)
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 0, iter.Value())
+
+		huge := Range[uint64](0, math.MaxUint64, 1)
+		assert.True(tt, huge.Next())
+		assert.Equal(tt, uint64(0), huge.Value())
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	iter := Repeat("a", 2)
+
+	assert.Equal(t, []string{"a", "a"}, iter.Collect())
+	assert.Zero(t, iter.Value())
+	assert.True(t, iter.Next())
+	assert.Equal(t, "a", iter.Value())
+	assert.True(t, iter.Next())
+	assert.False(t, iter.Next())
+	assert.Equal(t, []string{}, Repeat("a", -1).Collect())
+}
+
+func TestCycle(t *testing.T) {
+	t.Run("resettable source", func(tt *testing.T) {
+		iter := Cycle[int](NewIterator([]int{1, 2, 3}))
+
+		assert.Equal(tt, []int{1, 2, 3, 1, 2, 3, 1}, Limit(iter, 7).Collect())
+	})
+
+	t.Run("one-shot source", func(tt *testing.T) {
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 2
+		close(ch)
+		iter := Cycle[int](NewChanIterator(ch))
+
+		assert.Equal(tt, []int{1, 2, 1, 2, 1}, Limit(iter, 5).Collect())
+	})
+
+	t.Run("empty source", func(tt *testing.T) {
+		assert.False(tt, Cycle[int](NewIterator([]int{})).Next())
+	})
+}
+
+func TestCapabilities(t *testing.T) {
+	t.Run("limit collects the whole sequence", func(tt *testing.T) {
+		limited := Limit(Iterate(1, func(x int) int { return x + 1 }), 4)
+		limited.Next()
+
+		assert.Equal(tt, []int{1, 2, 3, 4}, Collect[int](limited))
+		assert.True(tt, limited.Next())
+		assert.Equal(tt, 2, limited.Value())
+
+		limited.Reset()
+		assert.True(tt, limited.Next())
+		assert.Equal(tt, 1, limited.Value())
+
+		slice := Limit[int](NewIterator([]int{1, 2, 3, 4, 5}), 3)
+		slice.Next()
+		assert.Equal(tt, []int{1, 2, 3}, slice.Collect())
+		assert.True(tt, slice.Next())
+		assert.Equal(tt, 2, slice.Value())
+	})
+
+	t.Run("limit replays a one-shot source after reset", func(tt *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+		limited := Limit[int](NewChanIterator(ch), 5)
+
+		assert.Equal(tt, []int{1, 2, 3}, limited.Collect())
+		limited.Reset()
+		assert.Equal(tt, []int{1, 2, 3}, Collect[int](limited))
+	})
+
+	t.Run("from iterator composes with filter and map", func(tt *testing.T) {
+		evens := NewFilterIter(FromIterator(Iterate(1, func(x int) int { return x + 1 })),
+			func(x int) bool { return x%2 == 0 })
+		squares := NewMapIter(evens, func(x int) int { return x * x })
+
+		assert.Equal(tt, []int{4, 16, 36}, Limit[int](squares, 3).Collect())
+	})
+
+	t.Run("from iterator keeps full iterators", func(tt *testing.T) {
+		iter := NewIterator([]int{1})
+
+		assert.Same(tt, iter, FromIterator[int](iter))
+	})
+}
@@ -1,8 +1,93 @@
 package iterator
 
-type IIterator[T any] interface {
+type Iterator[T any] interface {
 	Next() bool
 	Value() T
+}
+
+type Resettable interface {
 	Reset()
+}
+
+type Collectable[T any] interface {
 	Collect() []T
 }
+
+type IIterator[T any] interface {
+	Iterator[T]
+	Resettable
+	Collectable[T]
+}
+
+type ErrorIterator[T any] interface {
+	Iterator[T]
+	Err() error
+}
+
+type IErrorIterator[T any] interface {
+	IIterator[T]
+	Err() error
+}
+
+// Err returns the error iter stopped on when it has an Err method, so errors of
+// a source stay reachable through FromIterator, NewFilterIter and NewMapIter.
+func Err[T any](iter Iterator[T]) error {
+	return sourceErr(iter)
+}
+
+func sourceErr(source any) error {
+	if e, ok := source.(interface{ Err() error }); ok {
+		return e.Err()
+	}
+	return nil
+}
+
+// Reset rewinds iter when it is Resettable and reports whether it did.
+func Reset[T any](iter Iterator[T]) bool {
+	if r, ok := iter.(Resettable); ok {
+		r.Reset()
+		return true
+	}
+	return false
+}
+
+// Collect returns iter.Collect() when iter is Collectable. Otherwise it drains
+// the values iter has not produced yet, so it never returns for infinite
+// sources.
+func Collect[T any](iter Iterator[T]) []T {
+	if c, ok := iter.(Collectable[T]); ok {
+		return c.Collect()
+	}
+
+	var result []T
+	for iter.Next() {
+		result = append(result, iter.Value())
+	}
+	return result
+}
+
+type iteratorAdapter[T any] struct {
+	Iterator[T]
+}
+
+// FromIterator adapts iter to IIterator so it can be used with NewFilterIter and
+// NewMapIter. Reset, Collect and Err follow the package level Reset, Collect and
+// Err.
+func FromIterator[T any](iter Iterator[T]) IIterator[T] {
+	if it, ok := iter.(IIterator[T]); ok {
+		return it
+	}
+	return &iteratorAdapter[T]{iter}
+}
+
+func (it *iteratorAdapter[T]) Reset() {
+	Reset(it.Iterator)
+}
+
+func (it *iteratorAdapter[T]) Collect() []T {
+	return Collect(it.Iterator)
+}
+
+func (it *iteratorAdapter[T]) Err() error {
+	return sourceErr(it.Iterator)
+}
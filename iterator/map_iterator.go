@@ -30,6 +30,10 @@ func (it *mapIterator[T, K]) Collect() []K {
 	return result
 }
 
+func (it *mapIterator[T, K]) Err() error {
+	return sourceErr(it.source)
+}
+
 func NewMapIter[T any, K any](iter IIterator[T], f MapFunction[T, K]) IIterator[K] {
 	iter.Reset()
 	return &mapIterator[T, K]{iter, f}
@@ -0,0 +1,120 @@
+package iterator
+
+// MutableIterator iterates a slice while removing, replacing and inserting
+// elements. Changes are compacted into the backing array of the slice as the
+// iteration goes, retain-style, and a new array is only allocated once
+// insertions would overwrite elements that have not been read yet. The slice
+// passed to NewMutableIter must not be used afterwards, use Result instead.
+type MutableIterator[T any] struct {
+	elements []T
+	result   []T
+	after    []T
+	index    int
+	value    T
+	current  bool
+	removed  bool
+	inPlace  bool
+}
+
+func NewMutableIter[T any](arr []T) *MutableIterator[T] {
+	return &MutableIterator[T]{
+		elements: arr,
+		result:   arr[:0],
+		inPlace:  true,
+	}
+}
+
+func (it *MutableIterator[T]) Next() bool {
+	it.commit()
+	if it.index < len(it.elements) {
+		it.value = it.elements[it.index]
+		it.index++
+		it.current = true
+		it.removed = false
+		return true
+	}
+	return false
+}
+
+func (it *MutableIterator[T]) Value() T {
+	return it.value
+}
+
+// Remove drops the current element. It reports false when there is no current
+// element or it has already been removed.
+func (it *MutableIterator[T]) Remove() bool {
+	if !it.current || it.removed {
+		return false
+	}
+	it.removed = true
+	return true
+}
+
+// Set replaces the current element. It reports false when there is no current
+// element or it has been removed.
+func (it *MutableIterator[T]) Set(value T) bool {
+	if !it.current || it.removed {
+		return false
+	}
+	it.value = value
+	return true
+}
+
+// InsertBefore inserts value before the current element, or at the start when
+// Next has not been called yet. Inserted values are not visited by Next.
+func (it *MutableIterator[T]) InsertBefore(value T) {
+	it.write(value)
+}
+
+// InsertAfter inserts value after the current element. Successive calls keep
+// their order. Inserted values are not visited by Next.
+func (it *MutableIterator[T]) InsertAfter(value T) {
+	if !it.current {
+		it.write(value)
+		return
+	}
+	it.after = append(it.after, value)
+}
+
+// Result applies the pending changes and returns the resulting slice. Elements
+// that have not been visited yet are kept as they are.
+func (it *MutableIterator[T]) Result() []T {
+	it.commit()
+	for it.index < len(it.elements) {
+		it.write(it.elements[it.index])
+		it.index++
+	}
+
+	if it.inPlace {
+		var zero T
+		for i := len(it.result); i < len(it.elements); i++ {
+			it.elements[i] = zero
+		}
+	}
+	return it.result
+}
+
+func (it *MutableIterator[T]) commit() {
+	if !it.current {
+		return
+	}
+
+	if !it.removed {
+		it.write(it.value)
+	}
+	for i := range it.after {
+		it.write(it.after[i])
+	}
+	it.after = it.after[:0]
+	it.current = false
+}
+
+func (it *MutableIterator[T]) write(value T) {
+	if it.inPlace && len(it.result) >= it.index {
+		result := make([]T, len(it.result), len(it.result)+len(it.elements)-it.index+1)
+		copy(result, it.result)
+		it.result = result
+		it.inPlace = false
+	}
+	it.result = append(it.result, value)
+}
@@ -0,0 +1,68 @@
+package iterator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMutableIterator(t *testing.T) {
+	t.Run("remove and set in place", func(tt *testing.T) {
+		arr := []int{1, 2, 3, 4, 5, 6}
+		iter := NewMutableIter(arr)
+		for iter.Next() {
+			if iter.Value()%2 == 0 {
+				assert.True(tt, iter.Remove())
+				assert.False(tt, iter.Remove())
+				assert.False(tt, iter.Set(0))
+			} else {
+				assert.True(tt, iter.Set(iter.Value()*10))
+			}
+		}
+		result := iter.Result()
+
+		assert.Equal(tt, []int{10, 30, 50}, result)
+		assert.Same(tt, &arr[0], &result[0])
+		assert.Equal(tt, []int{10, 30, 50, 0, 0, 0}, arr)
+	})
+
+	t.Run("insert", func(tt *testing.T) {
+		iter := NewMutableIter([]string{"b", "d"})
+		iter.InsertBefore("a")
+		var visited []string
+		for iter.Next() {
+			visited = append(visited, iter.Value())
+			if iter.Value() == "b" {
+				iter.InsertAfter("c1")
+				iter.InsertAfter("c2")
+			}
+			if iter.Value() == "d" {
+				iter.InsertBefore("c3")
+				iter.Remove()
+				iter.InsertAfter("e")
+			}
+		}
+
+		assert.Equal(tt, []string{"b", "d"}, visited)
+		assert.Equal(tt, []string{"a", "b", "c1", "c2", "c3", "e"}, iter.Result())
+	})
+
+	t.Run("stop early", func(tt *testing.T) {
+		iter := NewMutableIter([]int{1, 2, 3, 4})
+		iter.Next()
+		iter.Remove()
+		iter.Next()
+		iter.InsertAfter(9)
+
+		assert.Equal(tt, []int{2, 9, 3, 4}, iter.Result())
+	})
+
+	t.Run("no current element", func(tt *testing.T) {
+		iter := NewMutableIter([]int{})
+
+		assert.False(tt, iter.Remove())
+		assert.False(tt, iter.Set(1))
+		assert.False(tt, iter.Next())
+		iter.InsertAfter(1)
+		assert.Equal(tt, []int{1}, iter.Result())
+	})
+}
@@ -0,0 +1,67 @@
+package iterator
+
+// PeekableIterator buffers the values read ahead of the current one, so Peek and
+// PeekN never skip values of the source.
+type PeekableIterator[T any] struct {
+	source IIterator[T]
+	ahead  []T
+	value  T
+}
+
+func NewPeekableIter[T any](iter IIterator[T]) *PeekableIterator[T] {
+	return &PeekableIterator[T]{source: iter}
+}
+
+func (it *PeekableIterator[T]) Next() bool {
+	if len(it.ahead) > 0 {
+		it.value = it.ahead[0]
+		it.ahead = it.ahead[1:]
+		return true
+	}
+
+	if it.source.Next() {
+		it.value = it.source.Value()
+		return true
+	}
+	return false
+}
+
+func (it *PeekableIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *PeekableIterator[T]) Peek() (T, bool) {
+	values := it.PeekN(1)
+	if len(values) == 0 {
+		var zero T
+		return zero, false
+	}
+	return values[0], true
+}
+
+// PeekN returns up to n of the following values without advancing. Fewer values
+// are returned when the source ends earlier.
+func (it *PeekableIterator[T]) PeekN(n int) []T {
+	for len(it.ahead) < n && it.source.Next() {
+		it.ahead = append(it.ahead, it.source.Value())
+	}
+	if n > len(it.ahead) {
+		n = len(it.ahead)
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	result := make([]T, n)
+	copy(result, it.ahead)
+	return result
+}
+
+func (it *PeekableIterator[T]) Reset() {
+	it.source.Reset()
+	*it = PeekableIterator[T]{source: it.source}
+}
+
+func (it *PeekableIterator[T]) Collect() []T {
+	return it.source.Collect()
+}
@@ -0,0 +1,57 @@
+package iterator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPeekableIterator(t *testing.T) {
+	t.Run("peek does not advance", func(tt *testing.T) {
+		iter := NewPeekableIter(NewIterator([]int{1, 2, 3}))
+
+		value, ok := iter.Peek()
+		assert.True(tt, ok)
+		assert.Equal(tt, 1, value)
+		assert.Zero(tt, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 1, iter.Value())
+		assert.Equal(tt, []int{2, 3}, iter.PeekN(5))
+		assert.Equal(tt, []int{2}, iter.PeekN(1))
+		assert.Nil(tt, iter.PeekN(0))
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 2, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 3, iter.Value())
+
+		_, ok = iter.Peek()
+		assert.False(tt, ok)
+		assert.False(tt, iter.Next())
+		assert.Equal(tt, []int{1, 2, 3}, iter.Collect())
+	})
+
+	t.Run("reset", func(tt *testing.T) {
+		iter := NewPeekableIter(NewIterator([]string{"a", "b"}))
+		iter.PeekN(2)
+		iter.Next()
+		iter.Reset()
+
+		assert.Zero(tt, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, "a", iter.Value())
+	})
+
+	t.Run("tokenizer", func(tt *testing.T) {
+		iter := NewPeekableIter(NewIterator([]rune("a<=b<c")))
+		var tokens []string
+		for iter.Next() {
+			token := string(iter.Value())
+			if next, ok := iter.Peek(); ok && iter.Value() == '<' && next == '=' {
+				iter.Next()
+				token += "="
+			}
+			tokens = append(tokens, token)
+		}
+
+		assert.Equal(tt, []string{"a", "<=", "b", "<", "c"}, tokens)
+	})
+}
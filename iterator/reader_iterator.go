@@ -0,0 +1,115 @@
+package iterator
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// readerIterator reads values until read returns an error. io.EOF ends the
+// iteration, any other error is kept and returned by Err. Readers cannot be
+// replayed, so it is not Resettable and Collect drains the remaining values.
+type readerIterator[T any] struct {
+	read  func() (T, error)
+	value T
+	err   error
+	done  bool
+}
+
+func newReaderIterator[T any](read func() (T, error)) ErrorIterator[T] {
+	return &readerIterator[T]{read: read}
+}
+
+func (it *readerIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	value, err := it.read()
+	if err != nil {
+		it.done = true
+		if !errors.Is(err, io.EOF) {
+			it.err = err
+		}
+		return false
+	}
+	it.value = value
+	return true
+}
+
+func (it *readerIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *readerIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *readerIterator[T]) Collect() []T {
+	var result []T
+	for it.Next() {
+		result = append(result, it.value)
+	}
+	return result
+}
+
+// Lines and SplitBy use a default bufio.Scanner, which stops with
+// bufio.ErrTooLong on tokens over bufio.MaxScanTokenSize. Use ScannerTokens
+// with a scanner configured through Buffer for longer tokens.
+func Lines(r io.Reader) ErrorIterator[string] {
+	return SplitBy(r, bufio.ScanLines)
+}
+
+func SplitBy(r io.Reader, split bufio.SplitFunc) ErrorIterator[string] {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(split)
+	return ScannerTokens(scanner)
+}
+
+func ScannerTokens(scanner *bufio.Scanner) ErrorIterator[string] {
+	return newReaderIterator(func() (string, error) {
+		if scanner.Scan() {
+			return scanner.Text(), nil
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	})
+}
+
+func CSVRecords(r io.Reader) ErrorIterator[[]string] {
+	return CSVReaderRecords(csv.NewReader(r))
+}
+
+func CSVReaderRecords(reader *csv.Reader) ErrorIterator[[]string] {
+	return newReaderIterator(reader.Read)
+}
+
+func JSONLines[T any](r io.Reader) ErrorIterator[T] {
+	decoder := json.NewDecoder(r)
+	return newReaderIterator(func() (T, error) {
+		var value T
+		err := decoder.Decode(&value)
+		return value, err
+	})
+}
+
+// Chunks reads r in chunks of size bytes. Every chunk is a new slice and only
+// the last one may be shorter than size. A size below 1 produces nothing.
+func Chunks(r io.Reader, size int) ErrorIterator[[]byte] {
+	if size < 1 {
+		return newReaderIterator(func() ([]byte, error) { return nil, io.EOF })
+	}
+
+	return newReaderIterator(func() ([]byte, error) {
+		buf := make([]byte, size)
+		n, err := io.ReadFull(r, buf)
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return buf[:n], nil
+		}
+		return buf[:n], err
+	})
+}
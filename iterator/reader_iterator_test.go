@@ -0,0 +1,141 @@
+package iterator
+
+import (
+	"bufio"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestLines(t *testing.T) {
+	t.Run("composes with filter and map", func(tt *testing.T) {
+		lines := Lines(strings.NewReader("INFO start\nERROR disk\nINFO done\nERROR net\n"))
+		errorsOnly := NewFilterIter(FromIterator[string](lines), func(x string) bool {
+			return strings.HasPrefix(x, "ERROR")
+		})
+		messages := NewMapIter(errorsOnly, func(x string) string {
+			return strings.TrimPrefix(x, "ERROR ")
+		})
+
+		assert.Equal(tt, []string{"disk", "net"}, messages.Collect())
+		assert.Nil(tt, lines.Err())
+	})
+
+	t.Run("read error", func(tt *testing.T) {
+		someError := errors.New("some error occured")
+		lines := Lines(&failingReader{data: "a\nb\n", err: someError})
+
+		assert.True(tt, lines.Next())
+		assert.Equal(tt, "a", lines.Value())
+		assert.True(tt, lines.Next())
+		assert.False(tt, lines.Next())
+		assert.ErrorIs(tt, lines.Err(), someError)
+		assert.False(tt, lines.Next())
+	})
+
+	t.Run("read error through filter and map", func(tt *testing.T) {
+		someError := errors.New("some error occured")
+		lines := Lines(&failingReader{data: "a\nbb\nc\n", err: someError})
+		short := NewFilterIter(FromIterator[string](lines), func(x string) bool { return len(x) == 1 })
+		upper := NewMapIter(short, strings.ToUpper)
+
+		assert.Equal(tt, []string{"A", "C"}, upper.Collect())
+		assert.ErrorIs(tt, Err[string](upper), someError)
+		assert.ErrorIs(tt, Err[string](short), someError)
+		assert.Nil(tt, Err[int](NewIterator([]int{1})))
+	})
+}
+
+func TestSplitBy(t *testing.T) {
+	words := SplitBy(strings.NewReader("hello  optio\nworld"), bufio.ScanWords)
+
+	assert.Equal(t, []string{"hello", "optio", "world"}, Collect[string](words))
+	assert.Nil(t, words.Err())
+}
+
+func TestScannerTokens(t *testing.T) {
+	long := strings.Repeat("x", bufio.MaxScanTokenSize+1)
+	input := "short\n" + long + "\nend\n"
+
+	t.Run("default buffer", func(tt *testing.T) {
+		lines := Lines(strings.NewReader(input))
+
+		assert.Equal(tt, []string{"short"}, Collect[string](lines))
+		assert.ErrorIs(tt, lines.Err(), bufio.ErrTooLong)
+	})
+
+	t.Run("larger buffer", func(tt *testing.T) {
+		scanner := bufio.NewScanner(strings.NewReader(input))
+		scanner.Buffer(nil, 2*bufio.MaxScanTokenSize)
+		lines := ScannerTokens(scanner)
+
+		assert.Equal(tt, []string{"short", long, "end"}, Collect[string](lines))
+		assert.Nil(tt, lines.Err())
+	})
+}
+
+func TestCSVRecords(t *testing.T) {
+	t.Run("normal case", func(tt *testing.T) {
+		records := CSVRecords(strings.NewReader("id,name\n1,\"a, b\"\n2,c\n"))
+
+		assert.Equal(tt, [][]string{{"id", "name"}, {"1", "a, b"}, {"2", "c"}}, Collect[[]string](records))
+		assert.Nil(tt, records.Err())
+	})
+
+	t.Run("malformed", func(tt *testing.T) {
+		records := CSVRecords(strings.NewReader("a,b\n1,2,3\n"))
+
+		assert.True(tt, records.Next())
+		assert.False(tt, records.Next())
+		assert.NotNil(tt, records.Err())
+	})
+}
+
+func TestJSONLines(t *testing.T) {
+	type event struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	t.Run("normal case", func(tt *testing.T) {
+		events := JSONLines[event](strings.NewReader("{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n"))
+		names := NewMapIter(FromIterator[event](events), func(e event) string { return e.Name })
+
+		assert.Equal(tt, []string{"a", "b"}, names.Collect())
+		assert.Nil(tt, events.Err())
+	})
+
+	t.Run("malformed", func(tt *testing.T) {
+		events := JSONLines[event](strings.NewReader("{\"id\":1}\n{\"id\":\n"))
+
+		assert.True(tt, events.Next())
+		assert.Equal(tt, 1, events.Value().ID)
+		assert.False(tt, events.Next())
+		assert.ErrorIs(tt, events.Err(), io.ErrUnexpectedEOF)
+	})
+}
+
+func TestChunks(t *testing.T) {
+	chunks := Chunks(strings.NewReader("abcdefgh"), 3)
+
+	assert.Equal(t, [][]byte{[]byte("abc"), []byte("def"), []byte("gh")}, Collect[[]byte](chunks))
+	assert.Nil(t, chunks.Err())
+	assert.Nil(t, Collect[[]byte](Chunks(strings.NewReader(""), 3)))
+	assert.Nil(t, Collect[[]byte](Chunks(strings.NewReader("abc"), 0)))
+}
@@ -0,0 +1,167 @@
+package iterator
+
+import "github.com/oculius/optio/fn"
+
+type PanicPolicy uint8
+
+const (
+	PanicRethrow PanicPolicy = iota
+	PanicSkip
+	PanicStop
+)
+
+type recoverMapIterator[T any, K any] struct {
+	source IIterator[T]
+	mapper MapFunction[T, K]
+	policy PanicPolicy
+	value  K
+	err    error
+}
+
+func NewRecoverMapIter[T any, K any](iter IIterator[T], f MapFunction[T, K], policy PanicPolicy) IErrorIterator[K] {
+	iter.Reset()
+	return &recoverMapIterator[T, K]{source: iter, mapper: f, policy: policy}
+}
+
+func NewRecoverMapIterFromArr[T any, K any](arr []T, f MapFunction[T, K], policy PanicPolicy) IErrorIterator[K] {
+	return &recoverMapIterator[T, K]{source: NewIterator(arr), mapper: f, policy: policy}
+}
+
+func (it *recoverMapIterator[T, K]) apply(v T) (result K, err error) {
+	if it.policy == PanicRethrow {
+		return it.mapper(v), nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fn.NewPanicError(r)
+		}
+	}()
+	return it.mapper(v), nil
+}
+
+func (it *recoverMapIterator[T, K]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.source.Next() {
+		value, err := it.apply(it.source.Value())
+		if err == nil {
+			it.value = value
+			return true
+		}
+		if it.policy == PanicStop {
+			it.err = err
+			return false
+		}
+	}
+	return false
+}
+
+func (it *recoverMapIterator[T, K]) Value() K {
+	return it.value
+}
+
+func (it *recoverMapIterator[T, K]) Err() error {
+	return it.err
+}
+
+func (it *recoverMapIterator[T, K]) Reset() {
+	it.source.Reset()
+	*it = recoverMapIterator[T, K]{source: it.source, mapper: it.mapper, policy: it.policy}
+}
+
+func (it *recoverMapIterator[T, K]) Collect() []K {
+	rawResult := it.source.Collect()
+	N := len(rawResult)
+	result := make([]K, 0, N)
+	for i := 0; i < N; i++ {
+		value, err := it.apply(rawResult[i])
+		if err != nil {
+			if it.policy == PanicStop {
+				it.err = err
+				break
+			}
+			continue
+		}
+		result = append(result, value)
+	}
+	return result
+}
+
+type recoverFilterIterator[T any] struct {
+	source IIterator[T]
+	pred   FilterFunction[T]
+	policy PanicPolicy
+	err    error
+}
+
+func NewRecoverFilterIter[T any](iter IIterator[T], f FilterFunction[T], policy PanicPolicy) IErrorIterator[T] {
+	return &recoverFilterIterator[T]{source: iter, pred: f, policy: policy}
+}
+
+func NewRecoverFilterIterFromArr[T any](arr []T, f FilterFunction[T], policy PanicPolicy) IErrorIterator[T] {
+	return &recoverFilterIterator[T]{source: NewIterator(arr), pred: f, policy: policy}
+}
+
+func (f *recoverFilterIterator[T]) apply(v T) (result bool, err error) {
+	if f.policy == PanicRethrow {
+		return f.pred(v), nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fn.NewPanicError(r)
+		}
+	}()
+	return f.pred(v), nil
+}
+
+func (f *recoverFilterIterator[T]) Next() bool {
+	if f.err != nil {
+		return false
+	}
+
+	for f.source.Next() {
+		ok, err := f.apply(f.source.Value())
+		if err != nil && f.policy == PanicStop {
+			f.err = err
+			return false
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *recoverFilterIterator[T]) Value() T {
+	return f.source.Value()
+}
+
+func (f *recoverFilterIterator[T]) Err() error {
+	return f.err
+}
+
+func (f *recoverFilterIterator[T]) Reset() {
+	f.source.Reset()
+	*f = recoverFilterIterator[T]{source: f.source, pred: f.pred, policy: f.policy}
+}
+
+func (f *recoverFilterIterator[T]) Collect() []T {
+	rawResult := f.source.Collect()
+	N := len(rawResult)
+	var result []T
+	for i := 0; i < N; i++ {
+		ok, err := f.apply(rawResult[i])
+		if err != nil && f.policy == PanicStop {
+			f.err = err
+			break
+		}
+		if ok {
+			result = append(result, rawResult[i])
+		}
+	}
+	return result
+}
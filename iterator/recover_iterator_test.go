@@ -0,0 +1,122 @@
+package iterator
+
+import (
+	"errors"
+	"github.com/oculius/optio/fn"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRecoverMapIterator(t *testing.T) {
+	mapper := func(x int) int {
+		if x == 3 {
+			panic("three")
+		}
+		return x * 10
+	}
+
+	t.Run("skip", func(tt *testing.T) {
+		iter := NewRecoverMapIterFromArr([]int{1, 2, 3, 4}, mapper, PanicSkip)
+
+		assert.Equal(tt, []int{10, 20, 40}, iter.Collect())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 10, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 40, iter.Value())
+		assert.False(tt, iter.Next())
+		assert.Nil(tt, iter.Err())
+	})
+
+	t.Run("stop", func(tt *testing.T) {
+		iter := NewRecoverMapIter(NewIterator([]int{1, 2, 3, 4}), mapper, PanicStop)
+
+		assert.Equal(tt, []int{10, 20}, iter.Collect())
+		assert.NotNil(tt, iter.Err())
+		assert.False(tt, iter.Next())
+
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 20, iter.Value())
+		assert.False(tt, iter.Next())
+		assert.False(tt, iter.Next())
+
+		var panicErr *fn.PanicError
+		assert.True(tt, errors.As(iter.Err(), &panicErr))
+		assert.Equal(tt, "three", panicErr.Value)
+
+		iter.Reset()
+		assert.Nil(tt, iter.Err())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 10, iter.Value())
+	})
+
+	t.Run("stop collect", func(tt *testing.T) {
+		iter := NewRecoverMapIterFromArr([]int{1, 2, 0, 4}, func(x int) int { return 10 / x }, PanicStop)
+
+		assert.Equal(tt, []int{10, 5}, iter.Collect())
+
+		var panicErr *fn.PanicError
+		assert.True(tt, errors.As(iter.Err(), &panicErr))
+	})
+
+	t.Run("rethrow", func(tt *testing.T) {
+		iter := NewRecoverMapIterFromArr([]int{3}, mapper, PanicRethrow)
+
+		assert.PanicsWithValue(tt, "three", func() { iter.Next() })
+	})
+}
+
+func TestRecoverFilterIterator(t *testing.T) {
+	pred := func(x int) bool {
+		if x == 3 {
+			panic("three")
+		}
+		return x%2 == 0
+	}
+
+	t.Run("skip", func(tt *testing.T) {
+		iter := NewRecoverFilterIterFromArr([]int{1, 2, 3, 4}, pred, PanicSkip)
+
+		assert.Equal(tt, []int{2, 4}, iter.Collect())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 2, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 4, iter.Value())
+		assert.False(tt, iter.Next())
+		assert.Nil(tt, iter.Err())
+	})
+
+	t.Run("stop", func(tt *testing.T) {
+		iter := NewRecoverFilterIter(NewIterator([]int{1, 2, 3, 4}), pred, PanicStop)
+
+		assert.Equal(tt, []int{2}, iter.Collect())
+		assert.NotNil(tt, iter.Err())
+
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.False(tt, iter.Next())
+		assert.NotNil(tt, iter.Err())
+	})
+
+	t.Run("rethrow", func(tt *testing.T) {
+		iter := NewRecoverFilterIterFromArr([]int{3}, pred, PanicRethrow)
+
+		assert.Panics(tt, func() { iter.Collect() })
+	})
+}
+
+func TestRecoverShortcut(t *testing.T) {
+	result, err := RecoverMap([]string{"a", "", "c"}, func(x string) byte { return x[0] }, PanicSkip)
+	assert.Equal(t, []byte{'a', 'c'}, result)
+	assert.Nil(t, err)
+
+	result, err = RecoverMap([]string{"a", "", "c"}, func(x string) byte { return x[0] }, PanicStop)
+	assert.Equal(t, []byte{'a'}, result)
+	assert.NotNil(t, err)
+
+	filtered, err := RecoverFilter([]string{"a", "", "c"}, func(x string) bool { return x[0] == 'c' }, PanicStop)
+	assert.Nil(t, filtered)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,62 @@
+package iterator
+
+func Fold[T any, K any](arr []T, seed K, fn ReducerFunction[T, K]) K {
+	result := seed
+	for i := range arr {
+		result = fn(result, arr[i])
+	}
+	return result
+}
+
+func FoldRight[T any, K any](arr []T, seed K, fn ReducerFunction[T, K]) K {
+	result := seed
+	for i := len(arr) - 1; i >= 0; i-- {
+		result = fn(result, arr[i])
+	}
+	return result
+}
+
+func ReduceRight[T any, K any](arr []T, fn ReducerFunction[T, K]) K {
+	var result K
+	return FoldRight(arr, result, fn)
+}
+
+// ReduceOption reduces arr using its first element as the seed. It reports
+// false when arr is empty instead of returning a zero value.
+func ReduceOption[T any](arr []T, fn ReducerFunction[T, T]) (T, bool) {
+	if len(arr) == 0 {
+		var zero T
+		return zero, false
+	}
+	return Fold(arr[1:], arr[0], fn), true
+}
+
+// Scan is like Fold but returns every intermediate result.
+func Scan[T any, K any](arr []T, seed K, fn ReducerFunction[T, K]) []K {
+	if arr == nil {
+		return nil
+	}
+
+	result := make([]K, len(arr))
+	accum := seed
+	for i := range arr {
+		accum = fn(accum, arr[i])
+		result[i] = accum
+	}
+	return result
+}
+
+// RunningReduce is like ReduceOption but returns every intermediate result,
+// starting with the first element.
+func RunningReduce[T any](arr []T, fn ReducerFunction[T, T]) []T {
+	if len(arr) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(arr))
+	result[0] = arr[0]
+	for i := 1; i < len(arr); i++ {
+		result[i] = fn(result[i-1], arr[i])
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+package iterator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	product := Fold([]int{1, 2, 3, 4}, 1, func(accum int, x int) int { return accum * x })
+	assert.Equal(t, 24, product)
+
+	joined := Fold([]int{1, 2, 3}, "#", func(accum string, x int) string { return accum + strconv.Itoa(x) })
+	assert.Equal(t, "#123", joined)
+	assert.Equal(t, "#", Fold([]int{}, "#", func(accum string, x int) string { return accum + strconv.Itoa(x) }))
+}
+
+func TestFoldRight(t *testing.T) {
+	joined := FoldRight([]int{1, 2, 3}, "#", func(accum string, x int) string { return accum + strconv.Itoa(x) })
+	assert.Equal(t, "#321", joined)
+
+	joined = ReduceRight([]string{"a", "b", "c"}, func(accum string, x string) string { return accum + x })
+	assert.Equal(t, "cba", joined)
+}
+
+func TestReduceOption(t *testing.T) {
+	min := func(accum int, x int) int {
+		if x < accum {
+			return x
+		}
+		return accum
+	}
+
+	result, ok := ReduceOption([]int{4, -2, 7}, min)
+	assert.True(t, ok)
+	assert.Equal(t, -2, result)
+
+	result, ok = ReduceOption([]int{}, min)
+	assert.False(t, ok)
+	assert.Zero(t, result)
+}
+
+func TestScan(t *testing.T) {
+	sum := func(accum int, x int) int { return accum + x }
+
+	assert.Equal(t, []int{11, 13, 16}, Scan([]int{1, 2, 3}, 10, sum))
+	assert.Nil(t, Scan([]int(nil), 10, sum))
+	assert.Equal(t, []int{1, 3, 6, 10}, RunningReduce([]int{1, 2, 3, 4}, sum))
+	assert.Nil(t, RunningReduce([]int{}, sum))
+}
@@ -20,3 +20,21 @@ func Reduce[T any, K any](arr []T, fn ReducerFunction[T, K]) K {
 
 	return result
 }
+
+func RecoverMap[T any, K any](arr []T, fn MapFunction[T, K], policy PanicPolicy) ([]K, error) {
+	iter := NewRecoverMapIterFromArr(arr, fn, policy)
+	result := make([]K, 0, len(arr))
+	for iter.Next() {
+		result = append(result, iter.Value())
+	}
+	return result, iter.Err()
+}
+
+func RecoverFilter[T any](arr []T, fn FilterFunction[T], policy PanicPolicy) ([]T, error) {
+	iter := NewRecoverFilterIterFromArr(arr, fn, policy)
+	var result []T
+	for iter.Next() {
+		result = append(result, iter.Value())
+	}
+	return result, iter.Err()
+}
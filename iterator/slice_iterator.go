@@ -1,21 +1,40 @@
 package iterator
 
-type Iterator[T any] struct {
+type SliceIterator[T any] struct {
 	elements []T
 	value    T
 	index    int
 	maxIndex int
 }
 
+type Bidirectional[T any] interface {
+	Iterator[T]
+	Prev() bool
+	HasPrev() bool
+}
+
+type RandomAccess interface {
+	Seek(int) bool
+	Index() int
+	Remaining() int
+}
+
+var _ Bidirectional[int] = NewSliceIterator[int](nil)
+var _ RandomAccess = NewSliceIterator[int](nil)
+
 func NewIterator[T any](arr []T) IIterator[T] {
-	return &Iterator[T]{
+	return NewSliceIterator(arr)
+}
+
+func NewSliceIterator[T any](arr []T) *SliceIterator[T] {
+	return &SliceIterator[T]{
 		elements: arr,
 		index:    0,
 		maxIndex: len(arr),
 	}
 }
 
-func (it *Iterator[T]) Next() bool {
+func (it *SliceIterator[T]) Next() bool {
 	if it.index < it.maxIndex {
 		it.value = it.elements[it.index]
 		it.index++
@@ -24,22 +43,79 @@ func (it *Iterator[T]) Next() bool {
 	return false
 }
 
-func (it *Iterator[T]) Value() T {
+func (it *SliceIterator[T]) Value() T {
 	return it.value
 }
 
-func (it *Iterator[T]) Reset() {
-	*it = Iterator[T]{
+func (it *SliceIterator[T]) Reset() {
+	*it = SliceIterator[T]{
 		elements: it.elements,
 		index:    0,
 		maxIndex: len(it.elements),
 	}
 }
 
-func (it *Iterator[T]) Collect() []T {
+func (it *SliceIterator[T]) Collect() []T {
 	result := make([]T, it.maxIndex)
 	for i := 0; i < it.maxIndex; i++ {
 		result[i] = it.elements[i]
 	}
 	return result
 }
+
+func (it *SliceIterator[T]) HasNext() bool {
+	return it.index < it.maxIndex
+}
+
+// Prev moves back to the element before the current one, so a following Next
+// yields the current element again.
+func (it *SliceIterator[T]) Prev() bool {
+	if it.index > 1 {
+		it.index--
+		it.value = it.elements[it.index-1]
+		return true
+	}
+	return false
+}
+
+func (it *SliceIterator[T]) HasPrev() bool {
+	return it.index > 1
+}
+
+// Index returns the index of the current element, or -1 before the first Next.
+func (it *SliceIterator[T]) Index() int {
+	return it.index - 1
+}
+
+func (it *SliceIterator[T]) Remaining() int {
+	return it.maxIndex - it.index
+}
+
+// Seek positions the iterator so that the following Next yields the element at
+// idx. The current element becomes the one before idx.
+func (it *SliceIterator[T]) Seek(idx int) bool {
+	if idx < 0 || idx > it.maxIndex {
+		return false
+	}
+
+	it.index = idx
+	if idx > 0 {
+		it.value = it.elements[idx-1]
+	} else {
+		var zero T
+		it.value = zero
+	}
+	return true
+}
+
+// Rewind moves the iterator n elements back without resetting it, so the last n
+// elements are yielded again.
+func (it *SliceIterator[T]) Rewind(n int) {
+	if n < 0 {
+		return
+	}
+	if n > it.index {
+		n = it.index
+	}
+	it.Seek(it.index - n)
+}
@@ -135,3 +135,68 @@ func BenchmarkIterator_Integration(b *testing.B) {
 		}
 	})
 }
+
+func TestSliceIterator_Navigation(t *testing.T) {
+	t.Run("prev", func(tt *testing.T) {
+		iter := NewSliceIterator([]string{"a", "b", "c"})
+
+		assert.False(tt, iter.HasPrev())
+		assert.False(tt, iter.Prev())
+		assert.True(tt, iter.Next())
+		assert.False(tt, iter.HasPrev())
+		assert.True(tt, iter.Next())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, "c", iter.Value())
+		assert.True(tt, iter.HasPrev())
+		assert.True(tt, iter.Prev())
+		assert.Equal(tt, "b", iter.Value())
+		assert.True(tt, iter.Prev())
+		assert.Equal(tt, "a", iter.Value())
+		assert.False(tt, iter.Prev())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, "b", iter.Value())
+	})
+
+	t.Run("seek, index and remaining", func(tt *testing.T) {
+		iter := NewSliceIterator([]int{10, 20, 30, 40})
+
+		assert.Equal(tt, -1, iter.Index())
+		assert.Equal(tt, 4, iter.Remaining())
+		assert.True(tt, iter.HasNext())
+		assert.True(tt, iter.Seek(2))
+		assert.Equal(tt, 20, iter.Value())
+		assert.Equal(tt, 1, iter.Index())
+		assert.Equal(tt, 2, iter.Remaining())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 30, iter.Value())
+		assert.Equal(tt, 2, iter.Index())
+		assert.True(tt, iter.Seek(4))
+		assert.False(tt, iter.HasNext())
+		assert.False(tt, iter.Next())
+		assert.False(tt, iter.Seek(5))
+		assert.False(tt, iter.Seek(-1))
+		assert.True(tt, iter.Seek(0))
+		assert.Zero(tt, iter.Value())
+		assert.Equal(tt, 4, iter.Remaining())
+	})
+
+	t.Run("rewind", func(tt *testing.T) {
+		iter := NewSliceIterator([]int{1, 2, 3, 4})
+		iter.Next()
+		iter.Next()
+		iter.Next()
+
+		iter.Rewind(2)
+		assert.Equal(tt, 1, iter.Value())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 2, iter.Value())
+
+		iter.Rewind(10)
+		assert.Equal(tt, -1, iter.Index())
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 1, iter.Value())
+
+		iter.Rewind(-1)
+		assert.Equal(tt, 0, iter.Index())
+	})
+}
@@ -0,0 +1,139 @@
+package iterator
+
+// windowIterator keeps at most size values of the source in memory and yields
+// every window as a new slice.
+type windowIterator[T any] struct {
+	source    IIterator[T]
+	size      int
+	step      int
+	partial   bool
+	buffer    []T
+	value     []T
+	started   bool
+	exhausted bool
+}
+
+func NewChunkIter[T any](iter IIterator[T], size int) IIterator[[]T] {
+	return NewWindowedIter(iter, size, size, true)
+}
+
+func NewWindowedIter[T any](iter IIterator[T], size, step int, partial bool) IIterator[[]T] {
+	return &windowIterator[T]{source: iter, size: size, step: step, partial: partial}
+}
+
+func (it *windowIterator[T]) Next() bool {
+	if it.size <= 0 || it.step <= 0 {
+		return false
+	}
+
+	if it.started {
+		if it.step <= len(it.buffer) {
+			it.buffer = append(it.buffer[:0], it.buffer[it.step:]...)
+		} else {
+			skip := it.step - len(it.buffer)
+			it.buffer = it.buffer[:0]
+			for ; skip > 0 && !it.exhausted; skip-- {
+				it.exhausted = !it.source.Next()
+			}
+		}
+	}
+	it.started = true
+
+	for len(it.buffer) < it.size && !it.exhausted {
+		if it.source.Next() {
+			it.buffer = append(it.buffer, it.source.Value())
+		} else {
+			it.exhausted = true
+		}
+	}
+
+	if len(it.buffer) == it.size || (it.partial && len(it.buffer) > 0) {
+		it.value = make([]T, len(it.buffer))
+		copy(it.value, it.buffer)
+		return true
+	}
+	it.buffer = it.buffer[:0]
+	it.value = nil
+	return false
+}
+
+func (it *windowIterator[T]) Value() []T {
+	return it.value
+}
+
+func (it *windowIterator[T]) Reset() {
+	it.source.Reset()
+	*it = windowIterator[T]{source: it.source, size: it.size, step: it.step, partial: it.partial}
+}
+
+func (it *windowIterator[T]) Collect() [][]T {
+	rawResult := it.source.Collect()
+	N := len(rawResult)
+	if N == 0 || it.size <= 0 || it.step <= 0 {
+		return nil
+	}
+
+	var result [][]T
+	for i := 0; i < N; i += it.step {
+		end := i + it.size
+		if end > N {
+			if !it.partial {
+				break
+			}
+			end = N
+		}
+		window := make([]T, end-i)
+		copy(window, rawResult[i:end])
+		result = append(result, window)
+	}
+	return result
+}
+
+type pairwiseIterator[T any] struct {
+	source  IIterator[T]
+	value   [2]T
+	started bool
+}
+
+func NewPairwiseIter[T any](iter IIterator[T]) IIterator[[2]T] {
+	return &pairwiseIterator[T]{source: iter}
+}
+
+func (it *pairwiseIterator[T]) Next() bool {
+	if !it.started {
+		it.started = true
+		if !it.source.Next() {
+			return false
+		}
+		it.value[1] = it.source.Value()
+	}
+
+	if it.source.Next() {
+		it.value = [2]T{it.value[1], it.source.Value()}
+		return true
+	}
+	return false
+}
+
+func (it *pairwiseIterator[T]) Value() [2]T {
+	return it.value
+}
+
+func (it *pairwiseIterator[T]) Reset() {
+	it.source.Reset()
+	*it = pairwiseIterator[T]{source: it.source}
+}
+
+func (it *pairwiseIterator[T]) Collect() [][2]T {
+	rawResult := it.source.Collect()
+	N := len(rawResult)
+	if N < 2 {
+		return nil
+	}
+
+	result := make([][2]T, N-1)
+	for i := 1; i < N; i++ {
+		result[i-1] = [2]T{rawResult[i-1], rawResult[i]}
+	}
+	return result
+}
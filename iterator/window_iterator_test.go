@@ -0,0 +1,87 @@
+package iterator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func collectNext[T any](iter Iterator[T]) []T {
+	var result []T
+	for iter.Next() {
+		result = append(result, iter.Value())
+	}
+	return result
+}
+
+func TestWindowedIterator(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+
+	testCases := []struct {
+		Title    string
+		Size     int
+		Step     int
+		Partial  bool
+		Expected [][]int
+	}{
+		{"sliding", 3, 1, false, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{"partial", 3, 2, true, [][]int{{1, 2, 3}, {3, 4, 5}, {5}}},
+		{"chunk", 2, 2, true, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"step larger than size", 2, 3, false, [][]int{{1, 2}, {4, 5}}},
+		{"step larger than size partial", 1, 4, true, [][]int{{1}, {5}}},
+		{"size larger than array", 6, 1, false, nil},
+		{"size larger than array partial", 6, 2, true, [][]int{{1, 2, 3, 4, 5}, {3, 4, 5}, {5}}},
+		{"invalid size", 0, 1, true, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Title, func(tt *testing.T) {
+			iter := NewWindowedIter(NewIterator(arr), tc.Size, tc.Step, tc.Partial)
+
+			assert.Equal(tt, tc.Expected, iter.Collect())
+			assert.Equal(tt, tc.Expected, collectNext[[]int](iter))
+			assert.False(tt, iter.Next())
+			iter.Reset()
+			assert.Equal(tt, tc.Expected, collectNext[[]int](iter))
+		})
+	}
+
+	t.Run("windows are independent", func(tt *testing.T) {
+		iter := NewWindowedIter(NewIterator(arr), 2, 1, false)
+		assert.True(tt, iter.Next())
+		first := iter.Value()
+		assert.True(tt, iter.Next())
+
+		assert.Equal(tt, []int{1, 2}, first)
+		assert.Equal(tt, []int{2, 3}, iter.Value())
+	})
+
+	t.Run("moving average", func(tt *testing.T) {
+		windows := NewWindowedIter(NewIterator([]float64{1, 2, 3, 4, 5}), 3, 1, false)
+		averages := NewMapIter(windows, func(w []float64) float64 {
+			return (w[0] + w[1] + w[2]) / 3
+		})
+
+		assert.Equal(tt, []float64{2, 3, 4}, averages.Collect())
+	})
+}
+
+func TestChunkIterator(t *testing.T) {
+	iter := NewChunkIter(NewIterator([]string{"a", "b", "c"}), 2)
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, iter.Collect())
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, collectNext[[]string](iter))
+}
+
+func TestPairwiseIterator(t *testing.T) {
+	iter := NewPairwiseIter(NewIterator([]int{1, 2, 3}))
+
+	assert.Equal(t, [][2]int{{1, 2}, {2, 3}}, iter.Collect())
+	assert.Equal(t, [][2]int{{1, 2}, {2, 3}}, collectNext[[2]int](iter))
+	iter.Reset()
+	assert.True(t, iter.Next())
+	assert.Equal(t, [2]int{1, 2}, iter.Value())
+
+	assert.Nil(t, NewPairwiseIter(NewIterator([]int{1})).Collect())
+	assert.False(t, NewPairwiseIter(NewIterator([]int{1})).Next())
+}
@@ -0,0 +1,278 @@
+package persistent
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+const (
+	nodeBits  = 5
+	nodeWidth = 1 << nodeBits
+	nodeMask  = nodeWidth - 1
+)
+
+// List is an immutable vector trie. Updates return a new List sharing most of
+// its structure with the original in O(log32 n).
+type List[T any] struct {
+	size  int
+	shift uint
+	root  *listNode[T]
+	tail  []T
+}
+
+type listNode[T any] struct {
+	children []*listNode[T]
+	values   []T
+}
+
+func NewList[T any](values ...T) *List[T] {
+	l := &List[T]{shift: nodeBits, root: &listNode[T]{}}
+	for len(values) > 0 {
+		n := nodeWidth
+		if len(values) < n {
+			n = len(values)
+		}
+
+		chunk := make([]T, n)
+		copy(chunk, values)
+		l = l.appendChunk(chunk)
+		values = values[n:]
+	}
+	return l
+}
+
+// NewListFromIterator, NewMapFromIterator and NewSetFromIterator take the whole
+// sequence of iter as returned by Collect, whatever its current position.
+func NewListFromIterator[T any](iter iterator.IIterator[T]) *List[T] {
+	return NewList(iterator.Collect[T](iter)...)
+}
+
+func (l *List[T]) Len() int {
+	return l.size
+}
+
+func (l *List[T]) Get(idx int) (T, bool) {
+	if idx < 0 || idx >= l.size {
+		var zero T
+		return zero, false
+	}
+	return l.leafFor(idx)[idx&nodeMask], true
+}
+
+// Set returns a list with the element at idx replaced by value, or l itself
+// when idx is out of range.
+func (l *List[T]) Set(idx int, value T) *List[T] {
+	if idx < 0 || idx >= l.size {
+		return l
+	}
+
+	result := *l
+	if idx >= l.tailOffset() {
+		result.tail = make([]T, len(l.tail))
+		copy(result.tail, l.tail)
+		result.tail[idx&nodeMask] = value
+	} else {
+		result.root = l.set(l.shift, l.root, idx, value)
+	}
+	return &result
+}
+
+func (l *List[T]) Append(value T) *List[T] {
+	if l.size-l.tailOffset() < nodeWidth {
+		result := *l
+		result.tail = make([]T, len(l.tail), len(l.tail)+1)
+		copy(result.tail, l.tail)
+		result.tail = append(result.tail, value)
+		result.size++
+		return &result
+	}
+	return l.appendChunk([]T{value})
+}
+
+// Pop returns a list without its last element, or l itself when it is empty.
+func (l *List[T]) Pop() *List[T] {
+	switch {
+	case l.size == 0:
+		return l
+	case l.size == 1:
+		return NewList[T]()
+	case len(l.tail) > 1:
+		result := *l
+		result.tail = l.tail[:len(l.tail)-1]
+		result.size--
+		return &result
+	}
+
+	result := &List[T]{size: l.size - 1, shift: l.shift, tail: l.leafFor(l.size - 2)}
+	result.root = l.popTail(l.shift, l.root)
+	if result.root == nil {
+		result.root = &listNode[T]{}
+	}
+	if result.shift > nodeBits && len(result.root.children) == 1 {
+		result.root = result.root.children[0]
+		result.shift -= nodeBits
+	}
+	return result
+}
+
+func (l *List[T]) ToSlice() []T {
+	if l.size == 0 {
+		return nil
+	}
+
+	result := make([]T, 0, l.size)
+	for i := 0; i < l.size; i += nodeWidth {
+		result = append(result, l.leafFor(i)...)
+	}
+	return result
+}
+
+func (l *List[T]) ForEach(consumer fn.SilentConsumer[T]) {
+	for i := 0; i < l.size; i += nodeWidth {
+		leaf := l.leafFor(i)
+		for j := range leaf {
+			consumer(leaf[j])
+		}
+	}
+}
+
+func (l *List[T]) Iterator() iterator.IIterator[T] {
+	return &listIterator[T]{list: l}
+}
+
+func (l *List[T]) tailOffset() int {
+	if l.size < nodeWidth {
+		return 0
+	}
+	return (l.size - 1) >> nodeBits << nodeBits
+}
+
+func (l *List[T]) leafFor(idx int) []T {
+	if idx >= l.tailOffset() {
+		return l.tail
+	}
+
+	node := l.root
+	for level := l.shift; level > 0; level -= nodeBits {
+		node = node.children[(idx>>level)&nodeMask]
+	}
+	return node.values
+}
+
+// appendChunk requires the tail of l to be empty or full.
+func (l *List[T]) appendChunk(chunk []T) *List[T] {
+	result := &List[T]{size: l.size + len(chunk), shift: l.shift, root: l.root, tail: chunk}
+	if l.size == 0 {
+		return result
+	}
+
+	leaf := &listNode[T]{values: l.tail}
+	if l.size>>nodeBits > 1<<l.shift {
+		result.root = &listNode[T]{children: []*listNode[T]{l.root, newListPath(l.shift, leaf)}}
+		result.shift += nodeBits
+	} else {
+		result.root = l.pushTail(l.shift, l.root, leaf)
+	}
+	return result
+}
+
+func (l *List[T]) pushTail(level uint, parent *listNode[T], leaf *listNode[T]) *listNode[T] {
+	idx := ((l.size - 1) >> level) & nodeMask
+	result := &listNode[T]{children: make([]*listNode[T], len(parent.children), idx+1)}
+	copy(result.children, parent.children)
+
+	child := leaf
+	if level > nodeBits {
+		if idx < len(parent.children) {
+			child = l.pushTail(level-nodeBits, parent.children[idx], leaf)
+		} else {
+			child = newListPath(level-nodeBits, leaf)
+		}
+	}
+
+	if idx < len(result.children) {
+		result.children[idx] = child
+	} else {
+		result.children = append(result.children, child)
+	}
+	return result
+}
+
+func (l *List[T]) popTail(level uint, node *listNode[T]) *listNode[T] {
+	idx := ((l.size - 2) >> level) & nodeMask
+	if level > nodeBits {
+		child := l.popTail(level-nodeBits, node.children[idx])
+		if child == nil && idx == 0 {
+			return nil
+		}
+
+		result := &listNode[T]{children: make([]*listNode[T], idx+1)}
+		copy(result.children, node.children)
+		if child == nil {
+			result.children = result.children[:idx]
+		} else {
+			result.children[idx] = child
+		}
+		return result
+	} else if idx == 0 {
+		return nil
+	}
+
+	result := &listNode[T]{children: make([]*listNode[T], idx)}
+	copy(result.children, node.children)
+	return result
+}
+
+func (l *List[T]) set(level uint, node *listNode[T], idx int, value T) *listNode[T] {
+	if level == 0 {
+		result := &listNode[T]{values: make([]T, len(node.values))}
+		copy(result.values, node.values)
+		result.values[idx&nodeMask] = value
+		return result
+	}
+
+	result := &listNode[T]{children: make([]*listNode[T], len(node.children))}
+	copy(result.children, node.children)
+	child := (idx >> level) & nodeMask
+	result.children[child] = l.set(level-nodeBits, node.children[child], idx, value)
+	return result
+}
+
+func newListPath[T any](level uint, leaf *listNode[T]) *listNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &listNode[T]{children: []*listNode[T]{newListPath(level-nodeBits, leaf)}}
+}
+
+type listIterator[T any] struct {
+	list  *List[T]
+	leaf  []T
+	index int
+	value T
+}
+
+func (it *listIterator[T]) Next() bool {
+	if it.index >= it.list.size {
+		return false
+	}
+
+	if it.index&nodeMask == 0 {
+		it.leaf = it.list.leafFor(it.index)
+	}
+	it.value = it.leaf[it.index&nodeMask]
+	it.index++
+	return true
+}
+
+func (it *listIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *listIterator[T]) Reset() {
+	*it = listIterator[T]{list: it.list}
+}
+
+func (it *listIterator[T]) Collect() []T {
+	return it.list.ToSlice()
+}
@@ -0,0 +1,136 @@
+package persistent
+
+import (
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	t.Run("append and pop across levels", func(tt *testing.T) {
+		const n = 32*32*32 + 100
+		var versions []*List[int]
+		l := NewList[int]()
+		for i := 0; i < n; i++ {
+			l = l.Append(i)
+			if i%997 == 0 {
+				versions = append(versions, l)
+			}
+		}
+
+		assert.Equal(tt, n, l.Len())
+		for i := 0; i < n; i++ {
+			v, ok := l.Get(i)
+			assert.True(tt, ok)
+			if v != i {
+				assert.Equal(tt, i, v)
+				break
+			}
+		}
+		for i, version := range versions {
+			last, _ := version.Get(i * 997)
+			assert.Equal(tt, i*997+1, version.Len())
+			assert.Equal(tt, i*997, last)
+		}
+
+		for i := n - 1; i >= 0; i-- {
+			l = l.Pop()
+			if l.Len() != i {
+				assert.Equal(tt, i, l.Len())
+				break
+			}
+			if i > 0 {
+				last, _ := l.Get(i - 1)
+				if last != i-1 {
+					assert.Equal(tt, i-1, last)
+					break
+				}
+			}
+		}
+		assert.Equal(tt, 0, l.Len())
+		assert.Same(tt, l, l.Pop())
+		assert.Equal(tt, makeRange(n), NewList(makeRange(n)...).ToSlice())
+		assert.Equal(tt, makeRange(len(versions)*997-996), versions[len(versions)-1].ToSlice())
+	})
+
+	t.Run("random operations", func(tt *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		model := makeRange(2000)
+		l := NewList(model...)
+		snapshot, snapshotModel := l, append([]int(nil), model...)
+
+		for step := 0; step < 5000; step++ {
+			switch op := rng.Intn(4); {
+			case op == 0 && len(model) > 0:
+				l = l.Pop()
+				model = model[:len(model)-1]
+			case op == 1 && len(model) > 0:
+				idx, value := rng.Intn(len(model)), rng.Int()
+				l = l.Set(idx, value)
+				model = append([]int(nil), model...)
+				model[idx] = value
+			default:
+				value := rng.Int()
+				l = l.Append(value)
+				model = append(model, value)
+			}
+		}
+
+		assert.Equal(tt, model, l.ToSlice())
+		assert.Equal(tt, snapshotModel, snapshot.ToSlice())
+	})
+
+	t.Run("set", func(tt *testing.T) {
+		l := NewList(makeRange(100)...)
+		updated := l.Set(5, -1).Set(99, -2)
+
+		first, _ := l.Get(5)
+		second, _ := updated.Get(5)
+		last, _ := updated.Get(99)
+		assert.Equal(tt, 5, first)
+		assert.Equal(tt, -1, second)
+		assert.Equal(tt, -2, last)
+		assert.Same(tt, l, l.Set(100, 0))
+		assert.Same(tt, l, l.Set(-1, 0))
+
+		_, ok := l.Get(100)
+		assert.False(tt, ok)
+	})
+
+	t.Run("conversions", func(tt *testing.T) {
+		values := makeRange(70)
+		l := NewListFromIterator[int](iterator.NewIterator(values))
+		values[0] = -1
+
+		assert.Equal(tt, makeRange(70), l.ToSlice())
+		assert.Equal(tt, makeRange(70), iterator.Collect[int](l.Iterator()))
+		assert.Nil(tt, NewList[int]().ToSlice())
+
+		advanced := iterator.NewIterator([]int{1, 2, 3})
+		advanced.Next()
+		assert.Equal(tt, []int{1, 2, 3}, NewListFromIterator(advanced).ToSlice())
+
+		iter := l.Iterator()
+		var visited []int
+		for iter.Next() {
+			visited = append(visited, iter.Value())
+		}
+		iter.Reset()
+		assert.True(tt, iter.Next())
+		assert.Equal(tt, 0, iter.Value())
+		assert.Equal(tt, makeRange(70), visited)
+
+		sum := 0
+		l.ForEach(func(v int) { sum += v })
+		assert.Equal(tt, 69*70/2, sum)
+	})
+}
+
+func makeRange(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = i
+	}
+	return result
+}
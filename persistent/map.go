@@ -0,0 +1,251 @@
+package persistent
+
+import (
+	"math/bits"
+
+	"github.com/oculius/optio/collection"
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+// Map is an immutable hash array mapped trie keyed with an fn.Equivalence.
+// Updates return a new Map sharing most of its structure with the original in
+// O(log32 n). Iteration follows hash order.
+type Map[K, V any] struct {
+	eq   fn.Equivalence[K]
+	size int
+	root *mapNode[K, V]
+}
+
+type mapNode[K, V any] struct {
+	bitmap uint32
+	slots  []mapSlot[K, V]
+}
+
+// mapSlot holds either a child node or the entries sharing hash, more than one
+// only when their hashes fully collide.
+type mapSlot[K, V any] struct {
+	node    *mapNode[K, V]
+	hash    uint64
+	entries []collection.Pair[K, V]
+}
+
+func NewMap[K, V any](eq fn.Equivalence[K], entries ...collection.Pair[K, V]) *Map[K, V] {
+	m := &Map[K, V]{eq: eq, root: &mapNode[K, V]{}}
+	for i := range entries {
+		m = m.Put(entries[i].Key, entries[i].Value)
+	}
+	return m
+}
+
+func NewMapFromIterator[K, V any](eq fn.Equivalence[K], iter iterator.IIterator[collection.Pair[K, V]]) *Map[K, V] {
+	return NewMap(eq, iter.Collect()...)
+}
+
+func (m *Map[K, V]) Len() int {
+	return m.size
+}
+
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	hash := m.eq.Hash(key)
+	node := m.root
+	for shift := uint(0); ; shift += nodeBits {
+		bit := uint32(1) << ((hash >> shift) & nodeMask)
+		if node.bitmap&bit == 0 {
+			break
+		}
+
+		slot := &node.slots[bits.OnesCount32(node.bitmap&(bit-1))]
+		if slot.node != nil {
+			node = slot.node
+			continue
+		}
+		if slot.hash == hash {
+			for i := range slot.entries {
+				if m.eq.Equals(slot.entries[i].Key, key) {
+					return slot.entries[i].Value, true
+				}
+			}
+		}
+		break
+	}
+
+	var zero V
+	return zero, false
+}
+
+func (m *Map[K, V]) Contains(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+func (m *Map[K, V]) Put(key K, value V) *Map[K, V] {
+	root, added := m.put(m.root, 0, m.eq.Hash(key), collection.NewPair(key, value))
+	result := &Map[K, V]{eq: m.eq, size: m.size, root: root}
+	if added {
+		result.size++
+	}
+	return result
+}
+
+// Remove returns a map without key, or m itself when key is absent.
+func (m *Map[K, V]) Remove(key K) *Map[K, V] {
+	root, removed := m.remove(m.root, 0, m.eq.Hash(key), key)
+	if !removed {
+		return m
+	}
+	return &Map[K, V]{eq: m.eq, size: m.size - 1, root: root}
+}
+
+func (m *Map[K, V]) Keys() []K {
+	var result []K
+	m.ForEach(func(key K, _ V) {
+		result = append(result, key)
+	})
+	return result
+}
+
+func (m *Map[K, V]) Values() []V {
+	var result []V
+	m.ForEach(func(_ K, value V) {
+		result = append(result, value)
+	})
+	return result
+}
+
+func (m *Map[K, V]) Entries() []collection.Pair[K, V] {
+	var result []collection.Pair[K, V]
+	m.root.forEach(func(entry collection.Pair[K, V]) {
+		result = append(result, entry)
+	})
+	return result
+}
+
+func (m *Map[K, V]) ForEach(consumer fn.SilentBiConsumer[K, V]) {
+	m.root.forEach(func(entry collection.Pair[K, V]) {
+		consumer(entry.Key, entry.Value)
+	})
+}
+
+func (m *Map[K, V]) Iterator() iterator.IIterator[collection.Pair[K, V]] {
+	return iterator.NewIterator(m.Entries())
+}
+
+func (m *Map[K, V]) put(node *mapNode[K, V], shift uint, hash uint64, entry collection.Pair[K, V]) (*mapNode[K, V], bool) {
+	bit := uint32(1) << ((hash >> shift) & nodeMask)
+	idx := bits.OnesCount32(node.bitmap & (bit - 1))
+	if node.bitmap&bit == 0 {
+		return node.insert(bit, idx, mapSlot[K, V]{hash: hash, entries: []collection.Pair[K, V]{entry}}), true
+	}
+
+	slot := node.slots[idx]
+	added := true
+	switch {
+	case slot.node != nil:
+		slot.node, added = m.put(slot.node, shift+nodeBits, hash, entry)
+	case slot.hash == hash:
+		entries := make([]collection.Pair[K, V], len(slot.entries), len(slot.entries)+1)
+		copy(entries, slot.entries)
+		for i := range entries {
+			if m.eq.Equals(entries[i].Key, entry.Key) {
+				entries[i] = entry
+				added = false
+				break
+			}
+		}
+		if added {
+			entries = append(entries, entry)
+		}
+		slot.entries = entries
+	default:
+		leaf := mapSlot[K, V]{hash: hash, entries: []collection.Pair[K, V]{entry}}
+		slot = mapSlot[K, V]{node: newMapBranch(shift+nodeBits, slot, leaf)}
+	}
+	return node.replace(idx, slot), added
+}
+
+func (m *Map[K, V]) remove(node *mapNode[K, V], shift uint, hash uint64, key K) (*mapNode[K, V], bool) {
+	bit := uint32(1) << ((hash >> shift) & nodeMask)
+	if node.bitmap&bit == 0 {
+		return node, false
+	}
+
+	idx := bits.OnesCount32(node.bitmap & (bit - 1))
+	slot := node.slots[idx]
+	if slot.node != nil {
+		child, removed := m.remove(slot.node, shift+nodeBits, hash, key)
+		if !removed {
+			return node, false
+		}
+		if len(child.slots) == 1 && child.slots[0].node == nil {
+			return node.replace(idx, child.slots[0]), true
+		}
+		slot.node = child
+		return node.replace(idx, slot), true
+	}
+
+	if slot.hash != hash {
+		return node, false
+	}
+	for i := range slot.entries {
+		if m.eq.Equals(slot.entries[i].Key, key) {
+			if len(slot.entries) == 1 {
+				return node.delete(bit, idx), true
+			}
+
+			entries := make([]collection.Pair[K, V], 0, len(slot.entries)-1)
+			entries = append(entries, slot.entries[:i]...)
+			slot.entries = append(entries, slot.entries[i+1:]...)
+			return node.replace(idx, slot), true
+		}
+	}
+	return node, false
+}
+
+// newMapBranch builds the nodes separating two leaves with different hashes.
+func newMapBranch[K, V any](shift uint, a, b mapSlot[K, V]) *mapNode[K, V] {
+	fragA, fragB := (a.hash>>shift)&nodeMask, (b.hash>>shift)&nodeMask
+	if fragA == fragB {
+		child := mapSlot[K, V]{node: newMapBranch(shift+nodeBits, a, b)}
+		return &mapNode[K, V]{bitmap: 1 << fragA, slots: []mapSlot[K, V]{child}}
+	}
+
+	if fragA > fragB {
+		a, b = b, a
+	}
+	return &mapNode[K, V]{bitmap: 1<<fragA | 1<<fragB, slots: []mapSlot[K, V]{a, b}}
+}
+
+func (n *mapNode[K, V]) insert(bit uint32, idx int, slot mapSlot[K, V]) *mapNode[K, V] {
+	slots := make([]mapSlot[K, V], 0, len(n.slots)+1)
+	slots = append(slots, n.slots[:idx]...)
+	slots = append(slots, slot)
+	slots = append(slots, n.slots[idx:]...)
+	return &mapNode[K, V]{bitmap: n.bitmap | bit, slots: slots}
+}
+
+func (n *mapNode[K, V]) replace(idx int, slot mapSlot[K, V]) *mapNode[K, V] {
+	slots := make([]mapSlot[K, V], len(n.slots))
+	copy(slots, n.slots)
+	slots[idx] = slot
+	return &mapNode[K, V]{bitmap: n.bitmap, slots: slots}
+}
+
+func (n *mapNode[K, V]) delete(bit uint32, idx int) *mapNode[K, V] {
+	slots := make([]mapSlot[K, V], 0, len(n.slots)-1)
+	slots = append(slots, n.slots[:idx]...)
+	slots = append(slots, n.slots[idx+1:]...)
+	return &mapNode[K, V]{bitmap: n.bitmap &^ bit, slots: slots}
+}
+
+func (n *mapNode[K, V]) forEach(consumer fn.SilentConsumer[collection.Pair[K, V]]) {
+	for i := range n.slots {
+		if n.slots[i].node != nil {
+			n.slots[i].node.forEach(consumer)
+			continue
+		}
+		for j := range n.slots[i].entries {
+			consumer(n.slots[i].entries[j])
+		}
+	}
+}
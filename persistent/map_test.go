@@ -0,0 +1,100 @@
+package persistent
+
+import (
+	"github.com/oculius/optio/collection"
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("basic", func(tt *testing.T) {
+		m := NewMap(fn.NewCaseInsensitiveEquivalence(), collection.NewPair("Go", 1), collection.NewPair("Rust", 2))
+		updated := m.Put("GO", 3).Remove("rust")
+
+		value, ok := m.Get("go")
+		assert.True(tt, ok)
+		assert.Equal(tt, 1, value)
+		assert.Equal(tt, 2, m.Len())
+
+		value, ok = updated.Get("go")
+		assert.True(tt, ok)
+		assert.Equal(tt, 3, value)
+		assert.False(tt, updated.Contains("Rust"))
+		assert.Equal(tt, 1, updated.Len())
+		assert.Same(tt, updated, updated.Remove("zig"))
+	})
+
+	t.Run("random operations", func(tt *testing.T) {
+		for _, eq := range []fn.Equivalence[int]{
+			fn.NewNaturalEquivalence[int](),
+			fn.NewEquivalence(func(a, b int) bool { return a == b }, func(v int) uint64 { return uint64(v % 50) }),
+			fn.NewEquivalence(func(a, b int) bool { return a == b }, func(v int) uint64 { return uint64(v%7) << 59 }),
+		} {
+			rng := rand.New(rand.NewSource(1))
+			model := map[int]int{}
+			m := NewMap[int, int](eq)
+			snapshot, snapshotLen := m, 0
+
+			for step := 0; step < 5000; step++ {
+				key := rng.Intn(500)
+				if rng.Intn(3) == 0 {
+					delete(model, key)
+					m = m.Remove(key)
+				} else {
+					model[key] = step
+					m = m.Put(key, step)
+				}
+				if step == 2500 {
+					snapshot, snapshotLen = m, len(model)
+				}
+			}
+
+			assert.Equal(tt, len(model), m.Len())
+			for key := 0; key < 500; key++ {
+				expected, expectedOk := model[key]
+				actual, ok := m.Get(key)
+				assert.Equal(tt, expectedOk, ok)
+				assert.Equal(tt, expected, actual)
+			}
+			assert.Equal(tt, snapshotLen, snapshot.Len())
+			assert.Equal(tt, snapshotLen, len(snapshot.Entries()))
+
+			for key := range model {
+				m = m.Remove(key)
+			}
+			assert.Equal(tt, 0, m.Len())
+			assert.Empty(tt, m.Entries())
+			assert.Empty(tt, m.root.slots)
+		}
+	})
+
+	t.Run("conversions", func(tt *testing.T) {
+		entries := []collection.Pair[string, int]{
+			collection.NewPair("a", 1), collection.NewPair("b", 2), collection.NewPair("c", 3),
+		}
+		m := NewMapFromIterator(fn.NewNaturalEquivalence[string](), iterator.NewIterator(entries))
+
+		keys := m.Keys()
+		sort.Strings(keys)
+		values := m.Values()
+		sort.Ints(values)
+		collected := iterator.Collect[collection.Pair[string, int]](m.Iterator())
+		sort.Slice(collected, func(i, j int) bool { return collected[i].Key < collected[j].Key })
+
+		assert.Equal(tt, []string{"a", "b", "c"}, keys)
+		assert.Equal(tt, []int{1, 2, 3}, values)
+		assert.Equal(tt, entries, collected)
+
+		advanced := iterator.NewIterator(entries)
+		advanced.Next()
+		assert.Equal(tt, 3, NewMapFromIterator(fn.NewNaturalEquivalence[string](), advanced).Len())
+
+		sum := 0
+		m.ForEach(func(_ string, v int) { sum += v })
+		assert.Equal(tt, 6, sum)
+	})
+}
@@ -0,0 +1,88 @@
+package persistent
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+)
+
+// Set is an immutable set backed by a Map.
+type Set[T any] struct {
+	m *Map[T, struct{}]
+}
+
+func NewSet[T any](eq fn.Equivalence[T], values ...T) *Set[T] {
+	m := NewMap[T, struct{}](eq)
+	for i := range values {
+		m = m.Put(values[i], struct{}{})
+	}
+	return &Set[T]{m: m}
+}
+
+func NewSetFromIterator[T any](eq fn.Equivalence[T], iter iterator.IIterator[T]) *Set[T] {
+	return NewSet(eq, iter.Collect()...)
+}
+
+func (s *Set[T]) Len() int {
+	return s.m.Len()
+}
+
+func (s *Set[T]) Contains(value T) bool {
+	return s.m.Contains(value)
+}
+
+// Add returns a set containing value, or s itself when it is already present.
+func (s *Set[T]) Add(value T) *Set[T] {
+	if s.m.Contains(value) {
+		return s
+	}
+	return &Set[T]{m: s.m.Put(value, struct{}{})}
+}
+
+// Remove returns a set without value, or s itself when it is absent.
+func (s *Set[T]) Remove(value T) *Set[T] {
+	m := s.m.Remove(value)
+	if m == s.m {
+		return s
+	}
+	return &Set[T]{m: m}
+}
+
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s
+	other.ForEach(func(value T) {
+		result = result.Add(value)
+	})
+	return result
+}
+
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	result := s
+	s.ForEach(func(value T) {
+		if !other.Contains(value) {
+			result = result.Remove(value)
+		}
+	})
+	return result
+}
+
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := s
+	other.ForEach(func(value T) {
+		result = result.Remove(value)
+	})
+	return result
+}
+
+func (s *Set[T]) ToSlice() []T {
+	return s.m.Keys()
+}
+
+func (s *Set[T]) ForEach(consumer fn.SilentConsumer[T]) {
+	s.m.ForEach(func(value T, _ struct{}) {
+		consumer(value)
+	})
+}
+
+func (s *Set[T]) Iterator() iterator.IIterator[T] {
+	return iterator.NewIterator(s.ToSlice())
+}
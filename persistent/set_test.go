@@ -0,0 +1,59 @@
+package persistent
+
+import (
+	"github.com/oculius/optio/fn"
+	"github.com/oculius/optio/iterator"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	eq := fn.NewNaturalEquivalence[int]()
+	sorted := func(s *Set[int]) []int {
+		values := s.ToSlice()
+		sort.Ints(values)
+		return values
+	}
+
+	t.Run("add and remove", func(tt *testing.T) {
+		s := NewSet(eq, 1, 2, 2, 3)
+		added := s.Add(4)
+		removed := s.Remove(1)
+
+		assert.Equal(tt, 3, s.Len())
+		assert.Equal(tt, []int{1, 2, 3}, sorted(s))
+		assert.Equal(tt, []int{1, 2, 3, 4}, sorted(added))
+		assert.Equal(tt, []int{2, 3}, sorted(removed))
+		assert.True(tt, added.Contains(4))
+		assert.False(tt, removed.Contains(1))
+		assert.Same(tt, s, s.Add(2))
+		assert.Same(tt, s, s.Remove(5))
+	})
+
+	t.Run("algebra", func(tt *testing.T) {
+		a := NewSet(eq, 1, 2, 3)
+		b := NewSetFromIterator(eq, iterator.NewIterator([]int{2, 3, 4}))
+
+		assert.Equal(tt, []int{1, 2, 3, 4}, sorted(a.Union(b)))
+		assert.Equal(tt, []int{2, 3}, sorted(a.Intersect(b)))
+		assert.Equal(tt, []int{1}, sorted(a.Difference(b)))
+		assert.Equal(tt, []int{1, 2, 3}, sorted(a))
+
+		advanced := iterator.NewIterator([]int{2, 3, 4})
+		advanced.Next()
+		assert.Equal(tt, sorted(b), sorted(NewSetFromIterator(eq, advanced)))
+	})
+
+	t.Run("iterate", func(tt *testing.T) {
+		s := NewSet(eq, 3, 1, 2)
+		values := iterator.Collect[int](s.Iterator())
+		sort.Ints(values)
+
+		sum := 0
+		s.ForEach(func(v int) { sum += v })
+
+		assert.Equal(tt, []int{1, 2, 3}, values)
+		assert.Equal(tt, 6, sum)
+	})
+}
@@ -0,0 +1,170 @@
+package sketch
+
+import (
+	"math"
+
+	"github.com/oculius/optio/fn"
+)
+
+// BloomFilter is a set membership test with no false negatives and a tunable
+// rate of false positives, using a fixed amount of memory.
+type BloomFilter[T any] struct {
+	bits   []uint64
+	m      uint64
+	k      uint64
+	hasher Hasher[T]
+}
+
+// NewBloomFilter sizes the filter to hold expected elements with a false
+// positive rate of about fpRate.
+func NewBloomFilter[T any](expected int, fpRate float64, hasher Hasher[T]) *BloomFilter[T] {
+	if expected < 1 {
+		expected = 1
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.01
+	}
+
+	m := math.Ceil(-float64(expected) * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(expected) * math.Ln2)
+	return NewBloomFilterWithSize(uint64(m), uint64(k), hasher)
+}
+
+// NewBloomFilterWithSize creates a filter of m bits using k hash functions.
+func NewBloomFilterWithSize[T any](m, k uint64, hasher Hasher[T]) *BloomFilter[T] {
+	if m < 1 {
+		m = 1
+	}
+	if k < 1 {
+		k = 1
+	}
+
+	return &BloomFilter[T]{
+		bits:   make([]uint64, (m+63)/64),
+		m:      m,
+		k:      k,
+		hasher: hasher,
+	}
+}
+
+func (b *BloomFilter[T]) Add(v T) {
+	h1, h2 := b.hashes(v)
+	for i := uint64(0); i < b.k; i++ {
+		idx := (h1 + i*h2) % b.m
+		b.bits[idx/64] |= 1 << (idx % 64)
+	}
+}
+
+// Contains reports whether v may have been added. A false result is always
+// correct, a true result may be a false positive.
+func (b *BloomFilter[T]) Contains(v T) bool {
+	h1, h2 := b.hashes(v)
+	for i := uint64(0); i < b.k; i++ {
+		idx := (h1 + i*h2) % b.m
+		if b.bits[idx/64]&(1<<(idx%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestAndAdd adds v and reports whether it may have been added before.
+func (b *BloomFilter[T]) TestAndAdd(v T) bool {
+	h1, h2 := b.hashes(v)
+	present := true
+	for i := uint64(0); i < b.k; i++ {
+		idx := (h1 + i*h2) % b.m
+		mask := uint64(1) << (idx % 64)
+		if b.bits[idx/64]&mask == 0 {
+			present = false
+			b.bits[idx/64] |= mask
+		}
+	}
+	return present
+}
+
+func (b *BloomFilter[T]) Clear() {
+	for i := range b.bits {
+		b.bits[i] = 0
+	}
+}
+
+func (b *BloomFilter[T]) Clone() *BloomFilter[T] {
+	bits := make([]uint64, len(b.bits))
+	copy(bits, b.bits)
+	return &BloomFilter[T]{bits: bits, m: b.m, k: b.k, hasher: b.hasher}
+}
+
+// Size returns the number of bits of the filter.
+func (b *BloomFilter[T]) Size() uint64 {
+	return b.m
+}
+
+func (b *BloomFilter[T]) HashCount() uint64 {
+	return b.k
+}
+
+func (b *BloomFilter[T]) hashes(v T) (uint64, uint64) {
+	h1 := b.hasher(v)
+	h2 := fn.Mix64(h1^0x9e3779b97f4a7c15) | 1
+	return h1, h2
+}
+
+// Merge adds the elements of other to b. Both filters must have the same size
+// and hash count, and should use the same hasher.
+func (b *BloomFilter[T]) Merge(other *BloomFilter[T]) error {
+	if b.m != other.m || b.k != other.k {
+		return ErrIncompatible
+	}
+
+	for i := range b.bits {
+		b.bits[i] |= other.bits[i]
+	}
+	return nil
+}
+
+func (b *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	e := newEncoder(kindBloomFilter, 16+8*len(b.bits))
+	e.uint64(b.m)
+	e.uint64(b.k)
+	for i := range b.bits {
+		e.uint64(b.bits[i])
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary replaces the content of b, keeping its hasher.
+func (b *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(kindBloomFilter, data)
+	m, k := d.uint64(), d.uint64()
+	if d.err != nil {
+		return d.err
+	}
+
+	words := m / 64
+	if m%64 != 0 {
+		words++
+	}
+	if m == 0 || k == 0 || k > m || len(d.buf)%8 != 0 || words != uint64(len(d.buf)/8) {
+		return ErrInvalidData
+	}
+
+	bits := make([]uint64, words)
+	for i := range bits {
+		bits[i] = d.uint64()
+	}
+	if err := d.finish(); err != nil {
+		return err
+	}
+
+	b.bits, b.m, b.k = bits, m, k
+	return nil
+}
+
+func NewBloomFilterFromBytes[T any](data []byte, hasher Hasher[T]) (*BloomFilter[T], error) {
+	b := &BloomFilter[T]{hasher: hasher}
+	if err := b.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
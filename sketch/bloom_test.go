@@ -0,0 +1,109 @@
+package sketch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestBloomFilter(t *testing.T) {
+	t.Run("no false negatives", func(tt *testing.T) {
+		filter := NewBloomFilter(1000, 0.01, StringHasher)
+		for i := 0; i < 1000; i++ {
+			filter.Add(strconv.Itoa(i))
+		}
+		for i := 0; i < 1000; i++ {
+			assert.True(tt, filter.Contains(strconv.Itoa(i)))
+		}
+	})
+
+	t.Run("false positive rate", func(tt *testing.T) {
+		filter := NewBloomFilter(10000, 0.01, NewIntegerHasher[int]())
+		for i := 0; i < 10000; i++ {
+			filter.Add(i)
+		}
+
+		falsePositives := 0
+		for i := 10000; i < 20000; i++ {
+			if filter.Contains(i) {
+				falsePositives++
+			}
+		}
+		assert.Less(tt, falsePositives, 200)
+		assert.Equal(tt, uint64(95851), filter.Size())
+		assert.Equal(tt, uint64(7), filter.HashCount())
+	})
+
+	t.Run("test and add", func(tt *testing.T) {
+		filter := NewBloomFilter(100, 0.01, BytesHasher)
+
+		assert.False(tt, filter.TestAndAdd([]byte("a")))
+		assert.True(tt, filter.TestAndAdd([]byte("a")))
+		assert.True(tt, filter.Contains([]byte("a")))
+	})
+
+	t.Run("clear and clone", func(tt *testing.T) {
+		filter := NewBloomFilter(100, 0.01, StringHasher)
+		filter.Add("a")
+		clone := filter.Clone()
+		filter.Clear()
+
+		assert.False(tt, filter.Contains("a"))
+		assert.True(tt, clone.Contains("a"))
+	})
+
+	t.Run("invalid parameters", func(tt *testing.T) {
+		filter := NewBloomFilter(0, 2, StringHasher)
+		filter.Add("a")
+
+		assert.True(tt, filter.Contains("a"))
+		assert.Equal(tt, uint64(1), NewBloomFilterWithSize(0, 0, StringHasher).Size())
+	})
+}
+
+func TestBloomFilterMergeAndEncoding(t *testing.T) {
+	t.Run("merge", func(tt *testing.T) {
+		a := NewBloomFilter(100, 0.01, StringHasher)
+		b := NewBloomFilter(100, 0.01, StringHasher)
+		a.Add("a")
+		b.Add("b")
+
+		assert.NoError(tt, a.Merge(b))
+		assert.True(tt, a.Contains("a"))
+		assert.True(tt, a.Contains("b"))
+		assert.ErrorIs(tt, a.Merge(NewBloomFilter(1000, 0.01, StringHasher)), ErrIncompatible)
+	})
+
+	t.Run("round trip", func(tt *testing.T) {
+		filter := NewBloomFilter(100, 0.01, StringHasher)
+		for i := 0; i < 100; i++ {
+			filter.Add(strconv.Itoa(i))
+		}
+
+		data, err := filter.MarshalBinary()
+		assert.NoError(tt, err)
+
+		decoded, err := NewBloomFilterFromBytes(data, StringHasher)
+		assert.NoError(tt, err)
+		assert.Equal(tt, filter.Size(), decoded.Size())
+		assert.Equal(tt, filter.HashCount(), decoded.HashCount())
+		for i := 0; i < 100; i++ {
+			assert.True(tt, decoded.Contains(strconv.Itoa(i)))
+		}
+	})
+
+	t.Run("invalid data", func(tt *testing.T) {
+		data, _ := NewBloomFilter(100, 0.01, StringHasher).MarshalBinary()
+
+		_, err := NewBloomFilterFromBytes(data[:len(data)-1], StringHasher)
+		assert.ErrorIs(tt, err, ErrInvalidData)
+		_, err = NewBloomFilterFromBytes(append(data, 0), StringHasher)
+		assert.ErrorIs(tt, err, ErrInvalidData)
+		_, err = NewBloomFilterFromBytes(nil, StringHasher)
+		assert.ErrorIs(tt, err, ErrInvalidData)
+
+		cms, _ := NewCountMinSketchWithSize(4, 4, StringHasher).MarshalBinary()
+		_, err = NewBloomFilterFromBytes(cms, StringHasher)
+		assert.ErrorIs(tt, err, ErrInvalidData)
+	})
+}
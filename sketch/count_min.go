@@ -0,0 +1,151 @@
+package sketch
+
+import (
+	"math"
+
+	"github.com/oculius/optio/fn"
+)
+
+// CountMinSketch estimates how many times values have been added using a fixed
+// amount of memory. Estimates never undercount.
+type CountMinSketch[T any] struct {
+	width  uint64
+	depth  uint64
+	counts []uint64
+	total  uint64
+	hasher Hasher[T]
+}
+
+// NewCountMinSketch sizes the sketch so that an estimate exceeds the true count
+// by more than epsilon times the total count with a probability of at most
+// delta.
+func NewCountMinSketch[T any](epsilon, delta float64, hasher Hasher[T]) *CountMinSketch[T] {
+	if epsilon <= 0 || epsilon >= 1 {
+		epsilon = 0.001
+	}
+	if delta <= 0 || delta >= 1 {
+		delta = 0.01
+	}
+
+	width := math.Ceil(math.E / epsilon)
+	depth := math.Ceil(math.Log(1 / delta))
+	return NewCountMinSketchWithSize(uint64(width), uint64(depth), hasher)
+}
+
+func NewCountMinSketchWithSize[T any](width, depth uint64, hasher Hasher[T]) *CountMinSketch[T] {
+	if width < 1 {
+		width = 1
+	}
+	if depth < 1 {
+		depth = 1
+	}
+
+	return &CountMinSketch[T]{
+		width:  width,
+		depth:  depth,
+		counts: make([]uint64, width*depth),
+		hasher: hasher,
+	}
+}
+
+func (s *CountMinSketch[T]) Add(v T, count uint64) {
+	h1, h2 := s.hashes(v)
+	for row := uint64(0); row < s.depth; row++ {
+		s.counts[row*s.width+(h1+row*h2)%s.width] += count
+	}
+	s.total += count
+}
+
+func (s *CountMinSketch[T]) Estimate(v T) uint64 {
+	h1, h2 := s.hashes(v)
+	result := uint64(math.MaxUint64)
+	for row := uint64(0); row < s.depth; row++ {
+		if c := s.counts[row*s.width+(h1+row*h2)%s.width]; c < result {
+			result = c
+		}
+	}
+	return result
+}
+
+func (s *CountMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+func (s *CountMinSketch[T]) Width() uint64 {
+	return s.width
+}
+
+func (s *CountMinSketch[T]) Depth() uint64 {
+	return s.depth
+}
+
+func (s *CountMinSketch[T]) Clear() {
+	for i := range s.counts {
+		s.counts[i] = 0
+	}
+	s.total = 0
+}
+
+// Merge adds the counts of other to s. Both sketches must have the same width
+// and depth, and should use the same hasher.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if s.width != other.width || s.depth != other.depth {
+		return ErrIncompatible
+	}
+
+	for i := range s.counts {
+		s.counts[i] += other.counts[i]
+	}
+	s.total += other.total
+	return nil
+}
+
+func (s *CountMinSketch[T]) MarshalBinary() ([]byte, error) {
+	e := newEncoder(kindCountMinSketch, 24+8*len(s.counts))
+	e.uint64(s.width)
+	e.uint64(s.depth)
+	e.uint64(s.total)
+	for i := range s.counts {
+		e.uint64(s.counts[i])
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary replaces the content of s, keeping its hasher.
+func (s *CountMinSketch[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(kindCountMinSketch, data)
+	width, depth, total := d.uint64(), d.uint64(), d.uint64()
+	if d.err != nil {
+		return d.err
+	}
+
+	cells := uint64(len(d.buf) / 8)
+	if width == 0 || depth == 0 || len(d.buf)%8 != 0 || cells%depth != 0 || width != cells/depth {
+		return ErrInvalidData
+	}
+
+	counts := make([]uint64, width*depth)
+	for i := range counts {
+		counts[i] = d.uint64()
+	}
+	if err := d.finish(); err != nil {
+		return err
+	}
+
+	s.width, s.depth, s.total, s.counts = width, depth, total, counts
+	return nil
+}
+
+func NewCountMinSketchFromBytes[T any](data []byte, hasher Hasher[T]) (*CountMinSketch[T], error) {
+	s := &CountMinSketch[T]{hasher: hasher}
+	if err := s.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *CountMinSketch[T]) hashes(v T) (uint64, uint64) {
+	h1 := s.hasher(v)
+	h2 := fn.Mix64(h1^0x9e3779b97f4a7c15) | 1
+	return h1, h2
+}
@@ -0,0 +1,67 @@
+package sketch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCountMinSketch(t *testing.T) {
+	t.Run("estimates", func(tt *testing.T) {
+		s := NewCountMinSketch(0.001, 0.01, NewIntegerHasher[int]())
+		for i := 0; i < 1000; i++ {
+			s.Add(i, uint64(i%10+1))
+		}
+
+		errors := 0
+		for i := 0; i < 1000; i++ {
+			estimate := s.Estimate(i)
+			assert.GreaterOrEqual(tt, estimate, uint64(i%10+1))
+			if estimate > uint64(i%10+1)+uint64(0.001*float64(s.Total())) {
+				errors++
+			}
+		}
+		assert.LessOrEqual(tt, errors, 10)
+		assert.Equal(tt, uint64(5500), s.Total())
+		assert.Equal(tt, uint64(2719), s.Width())
+		assert.Equal(tt, uint64(5), s.Depth())
+	})
+
+	t.Run("merge", func(tt *testing.T) {
+		a := NewCountMinSketchWithSize(100, 4, StringHasher)
+		b := NewCountMinSketchWithSize(100, 4, StringHasher)
+		a.Add("a", 3)
+		b.Add("a", 2)
+		b.Add("b", 1)
+
+		assert.NoError(tt, a.Merge(b))
+		assert.Equal(tt, uint64(5), a.Estimate("a"))
+		assert.Equal(tt, uint64(1), a.Estimate("b"))
+		assert.Equal(tt, uint64(6), a.Total())
+		assert.ErrorIs(tt, a.Merge(NewCountMinSketchWithSize(100, 5, StringHasher)), ErrIncompatible)
+	})
+
+	t.Run("round trip", func(tt *testing.T) {
+		s := NewCountMinSketchWithSize(100, 4, StringHasher)
+		s.Add("a", 3)
+
+		data, err := s.MarshalBinary()
+		assert.NoError(tt, err)
+
+		decoded, err := NewCountMinSketchFromBytes(data, StringHasher)
+		assert.NoError(tt, err)
+		assert.Equal(tt, uint64(3), decoded.Estimate("a"))
+		assert.Equal(tt, uint64(3), decoded.Total())
+
+		_, err = NewCountMinSketchFromBytes(data[:len(data)-8], StringHasher)
+		assert.ErrorIs(tt, err, ErrInvalidData)
+	})
+
+	t.Run("clear", func(tt *testing.T) {
+		s := NewCountMinSketchWithSize(0, 0, StringHasher)
+		s.Add("a", 3)
+		s.Clear()
+
+		assert.Equal(tt, uint64(0), s.Estimate("a"))
+		assert.Equal(tt, uint64(0), s.Total())
+	})
+}
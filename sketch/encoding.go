@@ -0,0 +1,81 @@
+package sketch
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	ErrIncompatible = errors.New("sketch: sketches have different parameters")
+	ErrInvalidData  = errors.New("sketch: invalid serialized data")
+)
+
+const encodingVersion = 1
+
+const (
+	kindBloomFilter byte = iota + 1
+	kindCountMinSketch
+	kindHyperLogLog
+)
+
+// Serialized sketches start with a kind byte and a version byte, followed by
+// their parameters and content as little endian integers. Hashers are not
+// serialized, the same hasher must be used when decoding.
+
+type encoder struct {
+	buf []byte
+}
+
+func newEncoder(kind byte, size int) *encoder {
+	buf := make([]byte, 0, size+2)
+	return &encoder{buf: append(buf, kind, encodingVersion)}
+}
+
+func (e *encoder) uint64(v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	e.buf = append(e.buf, buf[:]...)
+}
+
+func (e *encoder) bytes(v []byte) {
+	e.buf = append(e.buf, v...)
+}
+
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func newDecoder(kind byte, data []byte) *decoder {
+	if len(data) < 2 || data[0] != kind || data[1] != encodingVersion {
+		return &decoder{err: ErrInvalidData}
+	}
+	return &decoder{buf: data[2:]}
+}
+
+func (d *decoder) uint64() uint64 {
+	if d.err != nil || len(d.buf) < 8 {
+		d.err = ErrInvalidData
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+	return v
+}
+
+func (d *decoder) bytes(n uint64) []byte {
+	if d.err != nil || uint64(len(d.buf)) < n {
+		d.err = ErrInvalidData
+		return nil
+	}
+	v := d.buf[:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) finish() error {
+	if d.err == nil && len(d.buf) != 0 {
+		d.err = ErrInvalidData
+	}
+	return d.err
+}
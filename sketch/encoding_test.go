@@ -0,0 +1,81 @@
+package sketch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func encodeTestData(kind byte, values []uint64, extra int) []byte {
+	e := newEncoder(kind, 8*len(values)+extra)
+	for _, v := range values {
+		e.uint64(v)
+	}
+	return append(e.buf, make([]byte, extra)...)
+}
+
+func TestDecodeCorruptData(t *testing.T) {
+	t.Run("bloom filter", func(tt *testing.T) {
+		cases := map[string][]byte{
+			"overflowing size": encodeTestData(kindBloomFilter, []uint64{math.MaxUint64, 3}, 0),
+			"oversized":        encodeTestData(kindBloomFilter, []uint64{1 << 40, 3}, 8),
+			"undersized":       encodeTestData(kindBloomFilter, []uint64{64, 3}, 16),
+			"hash count":       encodeTestData(kindBloomFilter, []uint64{64, math.MaxUint64}, 8),
+			"unaligned":        encodeTestData(kindBloomFilter, []uint64{64, 3}, 9),
+			"truncated header": encodeTestData(kindBloomFilter, []uint64{64}, 4),
+		}
+		for name, data := range cases {
+			_, err := NewBloomFilterFromBytes(data, StringHasher)
+			assert.ErrorIs(tt, err, ErrInvalidData, name)
+		}
+
+		filter, err := NewBloomFilterFromBytes(encodeTestData(kindBloomFilter, []uint64{64, 3}, 8), StringHasher)
+		assert.NoError(tt, err)
+		filter.Add("a")
+		assert.True(tt, filter.Contains("a"))
+	})
+
+	t.Run("count-min sketch", func(tt *testing.T) {
+		cases := map[string][]byte{
+			"overflowing size": encodeTestData(kindCountMinSketch, []uint64{1 << 32, 1 << 32, 0}, 0),
+			"oversized":        encodeTestData(kindCountMinSketch, []uint64{1 << 40, 2, 0}, 16),
+			"undersized":       encodeTestData(kindCountMinSketch, []uint64{2, 2, 0}, 24),
+			"unaligned":        encodeTestData(kindCountMinSketch, []uint64{2, 2, 0}, 33),
+			"zero depth":       encodeTestData(kindCountMinSketch, []uint64{2, 0, 0}, 0),
+			"truncated header": encodeTestData(kindCountMinSketch, []uint64{2, 2}, 0),
+		}
+		for name, data := range cases {
+			_, err := NewCountMinSketchFromBytes(data, StringHasher)
+			assert.ErrorIs(tt, err, ErrInvalidData, name)
+		}
+
+		s, err := NewCountMinSketchFromBytes(encodeTestData(kindCountMinSketch, []uint64{2, 2, 0}, 32), StringHasher)
+		assert.NoError(tt, err)
+		s.Add("a", 1)
+		assert.Equal(tt, uint64(1), s.Estimate("a"))
+	})
+
+	t.Run("hyperloglog", func(tt *testing.T) {
+		registers := encodeTestData(kindHyperLogLog, []uint64{4}, 16)
+		registers[len(registers)-1] = 62
+
+		cases := map[string][]byte{
+			"precision":  encodeTestData(kindHyperLogLog, []uint64{math.MaxUint64}, 0),
+			"oversized":  encodeTestData(kindHyperLogLog, []uint64{4}, 17),
+			"undersized": encodeTestData(kindHyperLogLog, []uint64{4}, 15),
+			"register":   registers,
+			"truncated":  encodeTestData(kindHyperLogLog, nil, 4),
+		}
+		for name, data := range cases {
+			_, err := NewHyperLogLogFromBytes(data, StringHasher)
+			assert.ErrorIs(tt, err, ErrInvalidData, name)
+		}
+	})
+
+	t.Run("header", func(tt *testing.T) {
+		data := encodeTestData(kindBloomFilter, []uint64{64, 3}, 8)
+		data[1] = encodingVersion + 1
+		_, err := NewBloomFilterFromBytes(data, StringHasher)
+		assert.ErrorIs(tt, err, ErrInvalidData)
+	})
+}
@@ -0,0 +1,49 @@
+package sketch
+
+import (
+	"encoding"
+
+	"github.com/oculius/optio/fn"
+	"golang.org/x/exp/constraints"
+)
+
+// Hasher maps a value to a 64-bit hash. Sketches derive all their hash
+// functions from it, so it should spread its output over all 64 bits.
+type Hasher[T any] func(T) uint64
+
+func StringHasher(v string) uint64 {
+	return fn.HashString(v)
+}
+
+func BytesHasher(v []byte) uint64 {
+	return fn.HashBytes(v)
+}
+
+func NewIntegerHasher[T constraints.Integer]() Hasher[T] {
+	return func(v T) uint64 {
+		return fn.Mix64(uint64(v))
+	}
+}
+
+// NewComparableHasher hashes values with fn.HashComparable, so values equal
+// under == share a hash. It works for any comparable T but walks structs and
+// arrays by reflection, so dedicated hashers are faster.
+func NewComparableHasher[T comparable]() Hasher[T] {
+	return fn.HashComparable[T]
+}
+
+// NewBytesHasher hashes the byte representation of values returned by toBytes.
+func NewBytesHasher[T any](toBytes func(T) []byte) Hasher[T] {
+	return func(v T) uint64 {
+		return BytesHasher(toBytes(v))
+	}
+}
+
+// NewBinaryHasher hashes values by their MarshalBinary output. Values failing to
+// marshal are hashed as empty.
+func NewBinaryHasher[T encoding.BinaryMarshaler]() Hasher[T] {
+	return func(v T) uint64 {
+		data, _ := v.MarshalBinary()
+		return BytesHasher(data)
+	}
+}
@@ -0,0 +1,26 @@
+package sketch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"net/netip"
+	"testing"
+)
+
+func TestHashers(t *testing.T) {
+	type point struct{ X, Y int }
+
+	comparable := NewComparableHasher[point]()
+	assert.Equal(t, comparable(point{1, 2}), comparable(point{1, 2}))
+	assert.NotEqual(t, comparable(point{1, 2}), comparable(point{2, 1}))
+
+	floats := NewComparableHasher[float64]()
+	assert.Equal(t, floats(0), floats(math.Copysign(0, -1)))
+
+	bytes := NewBytesHasher(func(s string) []byte { return []byte(s) })
+	assert.Equal(t, StringHasher("abc"), bytes("abc"))
+
+	binary := NewBinaryHasher[netip.Addr]()
+	assert.Equal(t, binary(netip.MustParseAddr("10.0.0.1")), binary(netip.MustParseAddr("10.0.0.1")))
+	assert.NotEqual(t, binary(netip.MustParseAddr("10.0.0.1")), binary(netip.MustParseAddr("10.0.0.2")))
+}
@@ -0,0 +1,141 @@
+package sketch
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	MinHyperLogLogPrecision = 4
+	MaxHyperLogLogPrecision = 18
+)
+
+// HyperLogLog estimates the number of distinct values added with a standard
+// error of about 1.04 / sqrt(2^precision), using 2^precision bytes.
+type HyperLogLog[T any] struct {
+	precision uint8
+	registers []uint8
+	hasher    Hasher[T]
+}
+
+// NewHyperLogLog clamps precision between MinHyperLogLogPrecision and
+// MaxHyperLogLogPrecision.
+func NewHyperLogLog[T any](precision uint8, hasher Hasher[T]) *HyperLogLog[T] {
+	if precision < MinHyperLogLogPrecision {
+		precision = MinHyperLogLogPrecision
+	} else if precision > MaxHyperLogLogPrecision {
+		precision = MaxHyperLogLogPrecision
+	}
+
+	return &HyperLogLog[T]{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+		hasher:    hasher,
+	}
+}
+
+func (h *HyperLogLog[T]) Add(v T) {
+	x := h.hasher(v)
+	idx := x >> (64 - h.precision)
+	w := x<<h.precision | 1<<(h.precision-1)
+	rank := uint8(bits.LeadingZeros64(w) + 1)
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+func (h *HyperLogLog[T]) Count() uint64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+func (h *HyperLogLog[T]) Precision() uint8 {
+	return h.precision
+}
+
+func (h *HyperLogLog[T]) Clear() {
+	for i := range h.registers {
+		h.registers[i] = 0
+	}
+}
+
+// Merge adds the values of other to h. Both sketches must have the same
+// precision, and should use the same hasher.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision {
+		return ErrIncompatible
+	}
+
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+	return nil
+}
+
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	e := newEncoder(kindHyperLogLog, 8+len(h.registers))
+	e.uint64(uint64(h.precision))
+	e.bytes(h.registers)
+	return e.buf, nil
+}
+
+// UnmarshalBinary replaces the content of h, keeping its hasher.
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(kindHyperLogLog, data)
+	precision := d.uint64()
+	if d.err != nil {
+		return d.err
+	}
+	if precision < MinHyperLogLogPrecision || precision > MaxHyperLogLogPrecision {
+		return ErrInvalidData
+	}
+
+	registers := make([]uint8, 1<<precision)
+	copy(registers, d.bytes(uint64(len(registers))))
+	if err := d.finish(); err != nil {
+		return err
+	}
+	for _, r := range registers {
+		if uint64(r) > 65-precision {
+			return ErrInvalidData
+		}
+	}
+
+	h.precision, h.registers = uint8(precision), registers
+	return nil
+}
+
+func NewHyperLogLogFromBytes[T any](data []byte, hasher Hasher[T]) (*HyperLogLog[T], error) {
+	h := &HyperLogLog[T]{hasher: hasher}
+	if err := h.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
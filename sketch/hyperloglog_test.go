@@ -0,0 +1,67 @@
+package sketch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	relativeError := func(expected int, actual uint64) float64 {
+		return math.Abs(float64(actual)-float64(expected)) / float64(expected)
+	}
+
+	t.Run("count", func(tt *testing.T) {
+		for _, n := range []int{10, 1000, 100000} {
+			h := NewHyperLogLog(14, NewIntegerHasher[int]())
+			for i := 0; i < n; i++ {
+				h.Add(i)
+				h.Add(i)
+			}
+			assert.Less(tt, relativeError(n, h.Count()), 0.03, "n %d", n)
+		}
+	})
+
+	t.Run("empty", func(tt *testing.T) {
+		assert.Equal(tt, uint64(0), NewHyperLogLog(10, StringHasher).Count())
+	})
+
+	t.Run("precision is clamped", func(tt *testing.T) {
+		assert.Equal(tt, uint8(MinHyperLogLogPrecision), NewHyperLogLog(0, StringHasher).Precision())
+		assert.Equal(tt, uint8(MaxHyperLogLogPrecision), NewHyperLogLog(30, StringHasher).Precision())
+	})
+
+	t.Run("merge", func(tt *testing.T) {
+		a := NewHyperLogLog(12, NewIntegerHasher[int]())
+		b := NewHyperLogLog(12, NewIntegerHasher[int]())
+		for i := 0; i < 6000; i++ {
+			a.Add(i)
+			b.Add(i + 4000)
+		}
+
+		assert.NoError(tt, a.Merge(b))
+		assert.Less(tt, relativeError(10000, a.Count()), 0.05)
+		assert.ErrorIs(tt, a.Merge(NewHyperLogLog(10, NewIntegerHasher[int]())), ErrIncompatible)
+	})
+
+	t.Run("round trip", func(tt *testing.T) {
+		h := NewHyperLogLog(10, NewIntegerHasher[int]())
+		for i := 0; i < 500; i++ {
+			h.Add(i)
+		}
+
+		data, err := h.MarshalBinary()
+		assert.NoError(tt, err)
+
+		decoded, err := NewHyperLogLogFromBytes(data, NewIntegerHasher[int]())
+		assert.NoError(tt, err)
+		assert.Equal(tt, h.Count(), decoded.Count())
+
+		decoded.Clear()
+		assert.Equal(tt, uint64(0), decoded.Count())
+
+		data[2] = 40
+		_, err = NewHyperLogLogFromBytes(data, NewIntegerHasher[int]())
+		assert.ErrorIs(tt, err, ErrInvalidData)
+	})
+}